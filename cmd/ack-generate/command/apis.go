@@ -16,6 +16,7 @@ package command
 import (
 	"fmt"
 	"io/ioutil"
+	"os"
 	"path/filepath"
 	"strings"
 
@@ -120,6 +121,9 @@ func generateAPIs(cmd *cobra.Command, args []string) error {
 	if err = ts.Execute(); err != nil {
 		return err
 	}
+	if err = reportDeprecatedFields(g, svcAlias); err != nil {
+		return err
+	}
 
 	apisVersionPath = filepath.Join(optOutputPath, "apis", optGenVersion)
 	for path, contents := range ts.Executed() {
@@ -139,3 +143,23 @@ func generateAPIs(cmd *cobra.Command, args []string) error {
 	}
 	return nil
 }
+
+// reportDeprecatedFields writes the list of deprecated fields that are still
+// exposed in the generated API types of the AWS service API to stderr
+func reportDeprecatedFields(g *generate.Generator, svcAlias string) error {
+	dfields, err := g.GetDeprecatedFields()
+	if err != nil {
+		return err
+	}
+	if len(dfields) == 0 {
+		return nil
+	}
+	fmt.Fprintf(
+		os.Stderr, "WARNING: %d deprecated fields exposed in the %s API types:\n",
+		len(dfields), svcAlias,
+	)
+	for _, dfield := range dfields {
+		fmt.Fprintf(os.Stderr, "  %s: %s\n", dfield.Path, dfield.Message)
+	}
+	return nil
+}
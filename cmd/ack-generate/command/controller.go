@@ -28,6 +28,7 @@ import (
 
 	"github.com/aws-controllers-k8s/code-generator/pkg/generate"
 	ackgenerate "github.com/aws-controllers-k8s/code-generator/pkg/generate/ack"
+	"github.com/aws-controllers-k8s/code-generator/pkg/generate/templateset"
 	ackmodel "github.com/aws-controllers-k8s/code-generator/pkg/model"
 )
 
@@ -35,6 +36,7 @@ var (
 	cmdControllerPath string
 	pkgResourcePath   string
 	latestAPIVersion  string
+	optVerify         bool
 )
 
 var controllerCmd = &cobra.Command{
@@ -44,6 +46,9 @@ var controllerCmd = &cobra.Command{
 }
 
 func init() {
+	controllerCmd.PersistentFlags().BoolVar(
+		&optVerify, "verify", false, "If true, type-checks the generated API types and controller against the ACK runtime and aws-sdk-go and fails on any type error",
+	)
 	rootCmd.AddCommand(controllerCmd)
 }
 
@@ -106,6 +111,38 @@ func generateController(cmd *cobra.Command, args []string) error {
 			return err
 		}
 	}
+	if optVerify {
+		return verifyController(g, ts, svcAlias)
+	}
+	return nil
+}
+
+// verifyController type-checks the supplied executed controller template set,
+// along with the service's API types, and prints every type error found
+func verifyController(
+	g *generate.Generator,
+	ts *templateset.TemplateSet,
+	svcAlias string,
+) error {
+	apisTS, err := ackgenerate.APIs(g, optTemplateDirs)
+	if err != nil {
+		return err
+	}
+	if err = apisTS.Execute(); err != nil {
+		return err
+	}
+	verrs, err := ackgenerate.Verify(g, apisTS, ts, optAWSSDKGoVersion)
+	if err != nil {
+		return err
+	}
+	for _, verr := range verrs {
+		fmt.Fprintln(os.Stderr, verr)
+	}
+	if len(verrs) > 0 {
+		return fmt.Errorf(
+			"generated controller for %s has %d type error(s)", svcAlias, len(verrs),
+		)
+	}
 	return nil
 }
 
@@ -17,11 +17,9 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"strings"
 
-	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
 	"github.com/aws-controllers-k8s/code-generator/pkg/generate"
@@ -89,6 +87,9 @@ func generateCrossplane(_ *cobra.Command, args []string) error {
 	if err = ts.Execute(); err != nil {
 		return err
 	}
+	if err = reportDeprecatedFields(g, svcAlias); err != nil {
+		return err
+	}
 
 	for path, contents := range ts.Executed() {
 		if optDryRun {
@@ -105,12 +106,5 @@ func generateCrossplane(_ *cobra.Command, args []string) error {
 			return err
 		}
 	}
-	apiPath := filepath.Join(providerDir, "apis", svcAlias, optGenVersion)
-	controllerPath := filepath.Join(providerDir, "pkg", "controller", svcAlias)
-	// TODO(muvaf): goimports don't allow to be included as a library. Make sure
-	// goimports binary exists.
-	if err := exec.Command("goimports", "-w", apiPath, controllerPath).Run(); err != nil {
-		return errors.Wrap(err, "cannot run goimports")
-	}
 	return nil
 }
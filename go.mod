@@ -13,6 +13,7 @@ require (
 	github.com/iancoleman/strcase v0.1.3
 	github.com/operator-framework/api v0.6.0
 	github.com/pkg/errors v0.9.1
+	github.com/pmezard/go-difflib v1.0.0
 	github.com/spf13/cobra v1.1.1
 	github.com/stretchr/testify v1.7.0
 	golang.org/x/mod v0.4.1
@@ -68,11 +68,15 @@ func APIs(
 		apisFuncMap,
 	)
 
-	metaVars := g.MetaVars()
+	metaVars, err := g.MetaVars()
+	if err != nil {
+		return nil, err
+	}
 	apiVars := &templateAPIVars{
 		metaVars,
 		enumDefs,
 		typeDefs,
+		ackmodel.TypeDefImports(typeDefs),
 	}
 	for _, path := range apisTemplatePaths {
 		outPath := strings.TrimSuffix(filepath.Base(path), ".tpl")
@@ -100,6 +104,9 @@ type templateAPIVars struct {
 	templateset.MetaVars
 	EnumDefs []*ackmodel.EnumDef
 	TypeDefs []*ackmodel.TypeDef
+	// TypeImports is a map, keyed by an import string, with the map value
+	// being an optional alias, of additional packages the TypeDefs need
+	TypeImports map[string]string
 }
 
 // templateCRDVars contains template variables for the template that outputs Go
@@ -23,4 +23,5 @@ var DefaultConfig = config.Config{
 	},
 	IncludeACKMetadata:             true,
 	SetManyOutputNotFoundErrReturn: "return nil, ackerr.NotFound",
+	GenerateTarget:                 config.GenerateTargetAPIs,
 }
@@ -14,15 +14,16 @@
 package ack
 
 import (
+	"fmt"
 	"path/filepath"
 	"strings"
-	ttpl "text/template"
 
 	"github.com/aws-controllers-k8s/code-generator/pkg/generate"
 	"github.com/aws-controllers-k8s/code-generator/pkg/generate/code"
 	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/generate/config"
 	"github.com/aws-controllers-k8s/code-generator/pkg/generate/templateset"
 	ackmodel "github.com/aws-controllers-k8s/code-generator/pkg/model"
+	awssdkmodel "github.com/aws/aws-sdk-go/private/model/api"
 )
 
 var (
@@ -35,7 +36,6 @@ var (
 		"config/rbac/role-reader.yaml.tpl",
 		"config/rbac/role-writer.yaml.tpl",
 		"config/rbac/kustomization.yaml.tpl",
-		"config/crd/kustomization.yaml.tpl",
 	}
 	controllerIncludePaths = []string{
 		"config/controller/kustomization_def.yaml.tpl",
@@ -50,66 +50,7 @@ var (
 		"pkg/resource/sdk_update_not_implemented.go.tpl",
 	}
 	controllerCopyPaths = []string{}
-	controllerFuncMap   = ttpl.FuncMap{
-		"ToLower": strings.ToLower,
-		"ResourceExceptionCode": func(r *ackmodel.CRD, httpStatusCode int) string {
-			return r.ExceptionCode(httpStatusCode)
-		},
-		"GoCodeSetExceptionMessageCheck": func(r *ackmodel.CRD, httpStatusCode int) string {
-			return code.CheckExceptionMessage(r.Config(), r, httpStatusCode)
-		},
-		"GoCodeSetReadOneOutput": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int, performSpecUpdate bool) string {
-			return code.SetResource(r.Config(), r, ackmodel.OpTypeGet, sourceVarName, targetVarName, indentLevel, performSpecUpdate)
-		},
-		"GoCodeSetReadOneInput": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int) string {
-			return code.SetSDK(r.Config(), r, ackmodel.OpTypeGet, sourceVarName, targetVarName, indentLevel)
-		},
-		"GoCodeSetReadManyOutput": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int, performSpecUpdate bool) string {
-			return code.SetResource(r.Config(), r, ackmodel.OpTypeList, sourceVarName, targetVarName, indentLevel, performSpecUpdate)
-		},
-		"GoCodeSetReadManyInput": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int) string {
-			return code.SetSDK(r.Config(), r, ackmodel.OpTypeList, sourceVarName, targetVarName, indentLevel)
-		},
-		"GoCodeGetAttributesSetInput": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int) string {
-			return code.SetSDKGetAttributes(r.Config(), r, sourceVarName, targetVarName, indentLevel)
-		},
-		"GoCodeSetAttributesSetInput": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int) string {
-			return code.SetSDKSetAttributes(r.Config(), r, sourceVarName, targetVarName, indentLevel)
-		},
-		"GoCodeGetAttributesSetOutput": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int) string {
-			return code.SetResourceGetAttributes(r.Config(), r, sourceVarName, targetVarName, indentLevel)
-		},
-		"GoCodeSetCreateOutput": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int, performSpecUpdate bool) string {
-			return code.SetResource(r.Config(), r, ackmodel.OpTypeCreate, sourceVarName, targetVarName, indentLevel, performSpecUpdate)
-		},
-		"GoCodeSetCreateInput": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int) string {
-			return code.SetSDK(r.Config(), r, ackmodel.OpTypeCreate, sourceVarName, targetVarName, indentLevel)
-		},
-		"GoCodeSetUpdateOutput": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int, performSpecUpdate bool) string {
-			return code.SetResource(r.Config(), r, ackmodel.OpTypeUpdate, sourceVarName, targetVarName, indentLevel, performSpecUpdate)
-		},
-		"GoCodeSetUpdateInput": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int) string {
-			return code.SetSDK(r.Config(), r, ackmodel.OpTypeUpdate, sourceVarName, targetVarName, indentLevel)
-		},
-		"GoCodeSetDeleteInput": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int) string {
-			return code.SetSDK(r.Config(), r, ackmodel.OpTypeDelete, sourceVarName, targetVarName, indentLevel)
-		},
-		"GoCodeCompare": func(r *ackmodel.CRD, deltaVarName string, sourceVarName string, targetVarName string, indentLevel int) string {
-			return code.CompareResource(r.Config(), r, deltaVarName, sourceVarName, targetVarName, indentLevel)
-		},
-		"Empty": func(subject string) bool {
-			return strings.TrimSpace(subject) == ""
-		},
-		"GoCodeRequiredFieldsMissingFromReadOneInput": func(r *ackmodel.CRD, koVarName string, indentLevel int) string {
-			return code.CheckRequiredFieldsMissingFromShape(r, ackmodel.OpTypeGet, koVarName, indentLevel)
-		},
-		"GoCodeRequiredFieldsMissingFromGetAttributesInput": func(r *ackmodel.CRD, koVarName string, indentLevel int) string {
-			return code.CheckRequiredFieldsMissingFromShape(r, ackmodel.OpTypeGetAttributes, koVarName, indentLevel)
-		},
-		"GoCodeRequiredFieldsMissingFromSetAttributesInput": func(r *ackmodel.CRD, koVarName string, indentLevel int) string {
-			return code.CheckRequiredFieldsMissingFromShape(r, ackmodel.OpTypeSetAttributes, koVarName, indentLevel)
-		},
-	}
+	controllerFuncMap   = code.FuncMap()
 )
 
 // Controller returns a pointer to a TemplateSet containing all the templates
@@ -118,12 +59,22 @@ func Controller(
 	g *generate.Generator,
 	templateBasePaths []string,
 ) (*templateset.TemplateSet, error) {
-	crds, err := g.GetCRDs()
+	allCRDs, err := g.GetCRDs()
 	if err != nil {
 		return nil, err
 	}
+	// Some resources are configured to only have their API types generated
+	crds := []*ackmodel.CRD{}
+	for _, crd := range allCRDs {
+		if crd.GeneratesTarget(ackgenconfig.GenerateTargetController) {
+			crds = append(crds, crd)
+		}
+	}
 
-	metaVars := g.MetaVars()
+	metaVars, err := g.MetaVars()
+	if err != nil {
+		return nil, err
+	}
 
 	// Hook code can reference a template path, and we can look up the template
 	// in any of our base paths...
@@ -150,15 +101,28 @@ func Controller(
 	// First add all the CRD pkg/resource templates
 	targets := []string{
 		"delta.go.tpl",
+		"delta_test.go.tpl",
 		"descriptor.go.tpl",
 		"identifiers.go.tpl",
 		"manager.go.tpl",
 		"manager_factory.go.tpl",
+		"manager_test.go.tpl",
 		"resource.go.tpl",
 		"sdk.go.tpl",
+		"sdk_test.go.tpl",
 	}
 	for _, crd := range crds {
-		for _, target := range targets {
+		crdTargets := append([]string{}, targets...)
+		if crd.HasParent() {
+			crdTargets = append(crdTargets, "parent.go.tpl")
+		}
+		if crd.HasChildren() {
+			crdTargets = append(crdTargets, "children.go.tpl")
+		}
+		if crd.HasActions() {
+			crdTargets = append(crdTargets, "actions.go.tpl")
+		}
+		for _, target := range crdTargets {
 			outPath := filepath.Join("pkg/resource", crd.Names.Snake, strings.TrimSuffix(target, ".tpl"))
 			tplPath := filepath.Join("pkg/resource", target)
 			crdVars := &templateCRDVars{
@@ -179,6 +143,29 @@ func Controller(
 		return nil, err
 	}
 
+	// Next add the in-memory fake of the service API that tests exercise the
+	// resource managers against
+	// Resources may share operations, which the fake implements only once
+	fakedOps := map[string]bool{}
+	fakeAPIVars := &templateFakeAPIVars{
+		metaVars,
+		fakeTagOps(g.SDKAPI.API, fakedOps),
+	}
+	if err = ts.Add("pkg/fake/fake.go", "pkg/fake/fake.go.tpl", fakeAPIVars); err != nil {
+		return nil, err
+	}
+	for _, crd := range crds {
+		outPath := filepath.Join("pkg/fake", crd.Names.Snake+".go")
+		fakeVars := &templateFakeVars{
+			metaVars,
+			crd,
+			fakeOps(crd, fakedOps),
+		}
+		if err = ts.Add(outPath, "pkg/fake/resource.go.tpl", fakeVars); err != nil {
+			return nil, err
+		}
+	}
+
 	// Next add the template for pkg/version/version.go file
 	if err = ts.Add("pkg/version/version.go", "pkg/version/version.go.tpl", nil); err != nil {
 		return nil, err
@@ -192,6 +179,7 @@ func Controller(
 	cmdVars := &templateCmdVars{
 		metaVars,
 		snakeCasedCRDNames,
+		g.GetConfig(),
 	}
 	if err = ts.Add("cmd/controller/main.go", "cmd/controller/main.go.tpl", cmdVars); err != nil {
 		return nil, err
@@ -204,6 +192,34 @@ func Controller(
 			return nil, err
 		}
 	}
+
+	// The labels and annotations of CRDs are set by kustomize patches of the
+	// CRDs generated by controller-gen
+	metadataCRDs := []*ackmodel.CRD{}
+	for _, crd := range allCRDs {
+		if crd.CRDMetadata() == nil {
+			continue
+		}
+		metadataCRDs = append(metadataCRDs, crd)
+		outPath := fmt.Sprintf(
+			"config/crd/patches/%s_%s_metadata.yaml",
+			metaVars.APIGroup, strings.ToLower(crd.Plural),
+		)
+		crdVars := &templateCRDVars{
+			metaVars,
+			crd,
+		}
+		if err = ts.Add(outPath, "config/crd/patches/metadata.yaml.tpl", crdVars); err != nil {
+			return nil, err
+		}
+	}
+	crdConfigVars := &templateCRDConfigVars{
+		metaVars,
+		metadataCRDs,
+	}
+	if err = ts.Add("config/crd/kustomization.yaml", "config/crd/kustomization.yaml.tpl", crdConfigVars); err != nil {
+		return nil, err
+	}
 	return ts, nil
 }
 
@@ -212,6 +228,7 @@ func Controller(
 type templateCmdVars struct {
 	templateset.MetaVars
 	SnakeCasedCRDNames []string
+	GeneratorConfig    *ackgenconfig.Config
 }
 
 // templateConfigVars contains template variables for the templates that require
@@ -220,3 +237,119 @@ type templateConfigVars struct {
 	templateset.MetaVars
 	GeneratorConfig *ackgenconfig.Config
 }
+
+// templateCRDConfigVars contains template variables for the template that
+// outputs the kustomization of the CRDs
+type templateCRDConfigVars struct {
+	templateset.MetaVars
+	// MetadataCRDs contains the CRDs with labels or annotations, which are
+	// set by a kustomize patch
+	MetadataCRDs []*ackmodel.CRD
+}
+
+// templateFakeAPIVars contains template variables for the template that
+// outputs Go code for the in-memory fake of the service API
+type templateFakeAPIVars struct {
+	templateset.MetaVars
+	// TagOps contains the operations tagging resources that the fake
+	// implements
+	TagOps []fakeOp
+}
+
+// templateFakeVars contains template variables for the template that outputs
+// Go code for the operations of the in-memory fake of the service API used by
+// a single top-level resource
+type templateFakeVars struct {
+	templateset.MetaVars
+	CRD *ackmodel.CRD
+	// Ops contains the operations the fake implements for the resource
+	Ops []fakeOp
+}
+
+// fakeOp is an operation the in-memory fake of the service API implements
+type fakeOp struct {
+	// Type is the type of the operation for the resource, e.g. "ReadOne"
+	Type string
+	Op   *awssdkmodel.Operation
+	// Observed is the lifecycle status of the records that an "Action"
+	// operation transitions
+	Observed string
+}
+
+// fakeTagOpTypes contains the types of the operations tagging resources, by
+// the operation name used by the service APIs
+var fakeTagOpTypes = map[string]string{
+	"TagResource":            "Tag",
+	"AddTagsToResource":      "Tag",
+	"UntagResource":          "Untag",
+	"RemoveTagsFromResource": "Untag",
+	"ListTagsForResource":    "ListTags",
+}
+
+// fakeTagOps returns the operations of the supplied API that tag resources,
+// sorted by name, and adds them to the supplied set of operations implemented
+// by the in-memory fake of the service API. The generated resource managers
+// don't call these operations but the hooks of most controllers do.
+func fakeTagOps(
+	api *awssdkmodel.API,
+	implemented map[string]bool,
+) []fakeOp {
+	res := []fakeOp{}
+	for _, op := range api.OperationList() {
+		opType, found := fakeTagOpTypes[op.ExportedName]
+		if !found {
+			continue
+		}
+		implemented[op.ExportedName] = true
+		res = append(res, fakeOp{Type: opType, Op: op})
+	}
+	return res
+}
+
+// fakeOps returns the operations the generated resource manager of the
+// supplied resource calls, except those in the supplied set of operations
+// already implemented by the in-memory fake of the service API. The returned
+// operations are added to the set.
+func fakeOps(
+	crd *ackmodel.CRD,
+	implemented map[string]bool,
+) []fakeOp {
+	candidates := []fakeOp{{Type: "Create", Op: crd.Ops.Create}}
+	// The resource manager finds and updates resources with the first of
+	// these operations that the resource has
+	switch {
+	case crd.Ops.ReadOne != nil:
+		candidates = append(candidates, fakeOp{Type: "ReadOne", Op: crd.Ops.ReadOne})
+	case crd.Ops.GetAttributes != nil:
+		candidates = append(candidates, fakeOp{Type: "GetAttributes", Op: crd.Ops.GetAttributes})
+	case crd.Ops.ReadMany != nil:
+		candidates = append(candidates, fakeOp{Type: "ReadMany", Op: crd.Ops.ReadMany})
+	}
+	switch {
+	case crd.CustomUpdateMethodName() != "":
+	case crd.Ops.Update != nil:
+		candidates = append(candidates, fakeOp{Type: "Update", Op: crd.Ops.Update})
+	case crd.Ops.SetAttributes != nil:
+		candidates = append(candidates, fakeOp{Type: "SetAttributes", Op: crd.Ops.SetAttributes})
+	}
+	candidates = append(candidates, fakeOp{Type: "Delete", Op: crd.Ops.Delete})
+	// The resource manager transitions resources between runtime states with
+	// the operations of the resource's actions
+	for _, action := range crd.Actions() {
+		candidates = append(candidates, fakeOp{
+			Type:     "Action",
+			Op:       action.Op,
+			Observed: action.Observed[0],
+		})
+	}
+
+	res := []fakeOp{}
+	for _, candidate := range candidates {
+		if candidate.Op == nil || implemented[candidate.Op.ExportedName] {
+			continue
+		}
+		implemented[candidate.Op.ExportedName] = true
+		res = append(res, candidate)
+	}
+	return res
+}
@@ -66,7 +66,10 @@ func Release(
 		releaseFuncMap,
 	)
 
-	metaVars := g.MetaVars()
+	metaVars, err := g.MetaVars()
+	if err != nil {
+		return nil, err
+	}
 	releaseVars := &templateReleaseVars{
 		metaVars,
 		releaseVersion,
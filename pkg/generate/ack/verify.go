@@ -0,0 +1,418 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package ack
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/scanner"
+	"go/token"
+	"go/types"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime/debug"
+	"sort"
+	"strings"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/generate"
+	"github.com/aws-controllers-k8s/code-generator/pkg/generate/templateset"
+)
+
+const (
+	// sdkModulePath is the path of the aws-sdk-go module
+	sdkModulePath = "github.com/aws/aws-sdk-go"
+	// verifyGoVersion is the Go version declared by the go.mod of the
+	// temporary module holding a service controller being verified
+	verifyGoVersion = "1.14"
+	// verifyDeepCopyFileName is the name of the file declaring the deepcopy
+	// methods of the CRDs of a service controller being verified. These are
+	// normally generated by controller-gen and not by ack-generate.
+	verifyDeepCopyFileName = "zz_generated.deepcopy.go"
+)
+
+// verifyEnv is the environment the go command is run with to resolve the
+// packages of a service controller being verified. Modules are resolved only
+// from the local module cache.
+var verifyEnv = []string{
+	"GO111MODULE=on",
+	"GOFLAGS=-mod=mod",
+	"GOPROXY=off",
+	"GOSUMDB=off",
+	"GOWORK=off",
+}
+
+// VerifyError is a type error in a file of a generated service controller
+type VerifyError struct {
+	// Path is the path of the generated file, relative to the root of the
+	// service controller
+	Path string
+	// Line is the line of the generated file with the error
+	Line int
+	// Column is the column of the generated file with the error
+	Column int
+	// Message describes the error
+	Message string
+	// Template is the path of the template that rendered the generated file,
+	// or the empty string if the file wasn't rendered by a template
+	Template string
+	// CRD is the name of the CRD the template was executed for, or the empty
+	// string if the template isn't executed for a CRD
+	CRD string
+}
+
+// Error returns the position, message and origin of the type error
+func (e *VerifyError) Error() string {
+	msg := fmt.Sprintf("%s:%d:%d: %s", e.Path, e.Line, e.Column, e.Message)
+	if e.Template != "" {
+		msg += fmt.Sprintf(" (template %s", e.Template)
+		if e.CRD != "" {
+			msg += fmt.Sprintf(", CRD %s", e.CRD)
+		}
+		msg += ")"
+	}
+	return msg
+}
+
+// verifyPackage is a package of a service controller being verified, or one
+// of its dependencies, as listed by `go list -json`
+type verifyPackage struct {
+	ImportPath  string
+	Dir         string
+	GoFiles     []string
+	TestGoFiles []string
+	Export      string
+	ImportMap   map[string]string
+	ForTest     string
+	Module      *struct {
+		Path string
+	}
+}
+
+// Verify type-checks the files of the supplied executed API and controller
+// template sets against github.com/aws-controllers-k8s/runtime and
+// aws-sdk-go, and returns the type errors found in the generated files. A
+// non-nil error is returned if the service controller could not be
+// type-checked at all.
+//
+// The files are written to a temporary module with a go.mod pinning the
+// runtime, aws-sdk-go and every other module this generator is built with to
+// the same versions, which are resolved from the local module cache.
+// Dependencies that don't compile against these versions are type-checked
+// without their function bodies so that the generated packages importing them
+// can still be verified.
+func Verify(
+	g *generate.Generator,
+	// apis is the executed template set for the service's API types
+	apis *templateset.TemplateSet,
+	// controller is the executed template set for the service controller
+	controller *templateset.TemplateSet,
+	// sdkVersion is the version of aws-sdk-go the controller was generated
+	// from, or the empty string to use the version this generator is built
+	// with
+	sdkVersion string,
+) ([]*VerifyError, error) {
+	metaVars, err := g.MetaVars()
+	if err != nil {
+		return nil, err
+	}
+	modulePath := fmt.Sprintf(
+		"github.com/aws-controllers-k8s/%s-controller", metaVars.ServiceIDClean,
+	)
+	apisPath := filepath.Join("apis", metaVars.APIVersion)
+
+	goMod, err := verifyGoMod(modulePath, sdkVersion)
+	if err != nil {
+		return nil, err
+	}
+	moduleDir, err := ioutil.TempDir("", "ack-generate-verify")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(moduleDir)
+
+	files := map[string][]byte{"go.mod": []byte(goMod)}
+	for path, contents := range apis.Executed() {
+		files[filepath.Join(apisPath, path)] = contents.Bytes()
+	}
+	for path, contents := range controller.Executed() {
+		files[path] = contents.Bytes()
+	}
+	deepCopy, err := verifyDeepCopy(g, metaVars.APIVersion)
+	if err != nil {
+		return nil, err
+	}
+	files[filepath.Join(apisPath, verifyDeepCopyFileName)] = deepCopy
+	for path, contents := range files {
+		outPath := filepath.Join(moduleDir, path)
+		if err = os.MkdirAll(filepath.Dir(outPath), os.ModePerm); err != nil {
+			return nil, err
+		}
+		if err = ioutil.WriteFile(outPath, contents, 0644); err != nil {
+			return nil, err
+		}
+	}
+
+	pkgs, err := listVerifyPackages(moduleDir)
+	if err != nil {
+		return nil, err
+	}
+	res := []*VerifyError{}
+	report := func(pos token.Position, msg string) {
+		path, err := filepath.Rel(moduleDir, pos.Filename)
+		if err != nil {
+			path = pos.Filename
+		}
+		verr := &VerifyError{
+			Path:    path,
+			Line:    pos.Line,
+			Column:  pos.Column,
+			Message: msg,
+		}
+		if apisRel, err := filepath.Rel(apisPath, path); err == nil && !strings.HasPrefix(apisRel, "..") {
+			verr.Template, verr.CRD = apis.Origin(apisRel)
+		} else {
+			verr.Template, verr.CRD = controller.Origin(path)
+		}
+		res = append(res, verr)
+	}
+	checkVerifyPackages(pkgs, modulePath, report)
+	sort.SliceStable(res, func(i, j int) bool {
+		if res[i].Path != res[j].Path {
+			return res[i].Path < res[j].Path
+		}
+		if res[i].Line != res[j].Line {
+			return res[i].Line < res[j].Line
+		}
+		return res[i].Column < res[j].Column
+	})
+	return res, nil
+}
+
+// verifyGoMod returns the contents of the go.mod of the temporary module
+// holding the service controller being verified. The module requires every
+// module this generator is built with, at the same version, so that
+// github.com/aws-controllers-k8s/runtime, aws-sdk-go and their dependencies
+// can be resolved from the local module cache.
+func verifyGoMod(
+	modulePath string,
+	// sdkVersion is the version of aws-sdk-go to require, or the empty string
+	// to require the version this generator is built with
+	sdkVersion string,
+) (string, error) {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "", fmt.Errorf(
+			"unable to determine the module versions ack-generate is built with",
+		)
+	}
+	requires := []string{}
+	replaces := []string{}
+	for _, dep := range info.Deps {
+		version := dep.Version
+		if dep.Path == sdkModulePath && sdkVersion != "" {
+			version = sdkVersion
+		}
+		requires = append(requires, fmt.Sprintf("\t%s %s\n", dep.Path, version))
+		if dep.Replace == nil {
+			continue
+		}
+		replacement := dep.Replace.Path
+		if dep.Replace.Version != "" {
+			replacement += " " + dep.Replace.Version
+		}
+		replaces = append(replaces, fmt.Sprintf(
+			"\t%s %s => %s\n", dep.Path, dep.Version, replacement,
+		))
+	}
+	goMod := fmt.Sprintf("module %s\n\ngo %s\n", modulePath, verifyGoVersion)
+	if len(requires) > 0 {
+		goMod += "\nrequire (\n" + strings.Join(requires, "") + ")\n"
+	}
+	if len(replaces) > 0 {
+		goMod += "\nreplace (\n" + strings.Join(replaces, "") + ")\n"
+	}
+	return goMod, nil
+}
+
+// verifyDeepCopy returns the contents of a file declaring the deepcopy
+// methods of the supplied generator's CRDs. The service controller calls these
+// methods, which are normally generated by controller-gen. The methods are
+// only ever type-checked.
+func verifyDeepCopy(
+	g *generate.Generator,
+	apiVersion string,
+) ([]byte, error) {
+	crds, err := g.GetCRDs()
+	if err != nil {
+		return nil, err
+	}
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "package %s\n\n", apiVersion)
+	b.WriteString("import \"k8s.io/apimachinery/pkg/runtime\"\n")
+	for _, crd := range crds {
+		fmt.Fprintf(&b, `
+func (in *%[1]s) DeepCopy() *%[1]s {
+	out := *in
+	return &out
+}
+
+func (in *%[1]s) DeepCopyObject() runtime.Object {
+	return in.DeepCopy()
+}
+
+func (in *%[1]sList) DeepCopyObject() runtime.Object {
+	out := *in
+	return &out
+}
+`, crd.Kind)
+	}
+	return b.Bytes(), nil
+}
+
+// listVerifyPackages returns the packages, and tests, of the module in the
+// supplied directory along with all of their dependencies, by import path.
+// Dependencies that compile have the path to their export data.
+func listVerifyPackages(moduleDir string) (map[string]*verifyPackage, error) {
+	cmd := exec.Command(
+		"go", "list", "-e", "-deps", "-test", "-export", "-json", "./...",
+	)
+	cmd.Dir = moduleDir
+	cmd.Env = append(os.Environ(), verifyEnv...)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	// `go list -export` fails when a dependency doesn't compile, in which case
+	// the dependency is listed without export data
+	if err := cmd.Run(); err != nil && stdout.Len() == 0 {
+		return nil, fmt.Errorf(
+			"unable to list the packages of the service controller: %v: %s",
+			err, stderr.String(),
+		)
+	}
+	res := map[string]*verifyPackage{}
+	dec := json.NewDecoder(&stdout)
+	for {
+		pkg := &verifyPackage{}
+		if err := dec.Decode(pkg); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		// We type-check the test files of a package along with the package,
+		// so the test variants of packages are skipped
+		if pkg.ForTest != "" || strings.HasSuffix(pkg.ImportPath, ".test") {
+			continue
+		}
+		res[pkg.ImportPath] = pkg
+	}
+	return res, nil
+}
+
+// checkVerifyPackages type-checks the packages, and tests, of the supplied
+// module and calls the supplied function with the position and message of
+// every error found
+func checkVerifyPackages(
+	pkgs map[string]*verifyPackage,
+	modulePath string,
+	report func(token.Position, string),
+) {
+	fset := token.NewFileSet()
+	checked := map[string]*types.Package{}
+	exported := importer.ForCompiler(fset, "gc", func(path string) (io.ReadCloser, error) {
+		return os.Open(pkgs[path].Export)
+	})
+	isGenerated := func(pkg *verifyPackage) bool {
+		return pkg.Module != nil && pkg.Module.Path == modulePath
+	}
+
+	var check func(pkg *verifyPackage) *types.Package
+	check = func(pkg *verifyPackage) *types.Package {
+		if checked, found := checked[pkg.ImportPath]; found {
+			return checked
+		}
+		generated := isGenerated(pkg)
+		fileNames := append([]string{}, pkg.GoFiles...)
+		if generated {
+			fileNames = append(fileNames, pkg.TestGoFiles...)
+		}
+		files := []*ast.File{}
+		for _, fileName := range fileNames {
+			f, err := parser.ParseFile(fset, filepath.Join(pkg.Dir, fileName), nil, 0)
+			if err != nil {
+				if errList, ok := err.(scanner.ErrorList); ok && generated {
+					for _, err := range errList {
+						report(err.Pos, err.Msg)
+					}
+				}
+				continue
+			}
+			files = append(files, f)
+		}
+		conf := types.Config{
+			Importer: verifyImporter(func(path string) (*types.Package, error) {
+				if mapped, found := pkg.ImportMap[path]; found {
+					path = mapped
+				}
+				if path == "unsafe" {
+					return types.Unsafe, nil
+				}
+				dep, found := pkgs[path]
+				if !found {
+					return nil, fmt.Errorf("package %s not found", path)
+				}
+				if dep.Export != "" && !isGenerated(dep) {
+					return exported.Import(path)
+				}
+				return check(dep), nil
+			}),
+			// Dependencies without export data don't compile, which we ignore
+			// as long as the generated packages using them type-check
+			IgnoreFuncBodies: !generated,
+			Error: func(err error) {
+				if terr, ok := err.(types.Error); ok && generated {
+					report(terr.Fset.Position(terr.Pos), terr.Msg)
+				}
+			},
+		}
+		// Errors are reported through the Error func
+		checked[pkg.ImportPath], _ = conf.Check(pkg.ImportPath, fset, files, nil)
+		return checked[pkg.ImportPath]
+	}
+
+	importPaths := []string{}
+	for importPath, pkg := range pkgs {
+		if isGenerated(pkg) {
+			importPaths = append(importPaths, importPath)
+		}
+	}
+	sort.Strings(importPaths)
+	for _, importPath := range importPaths {
+		check(pkgs[importPath])
+	}
+}
+
+// verifyImporter is a types.Importer calling the underlying function
+type verifyImporter func(path string) (*types.Package, error)
+
+// Import returns the package with the supplied import path
+func (f verifyImporter) Import(path string) (*types.Package, error) {
+	return f(path)
+}
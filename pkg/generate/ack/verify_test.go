@@ -0,0 +1,149 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package ack_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/generate"
+	"github.com/aws-controllers-k8s/code-generator/pkg/generate/ack"
+	"github.com/aws-controllers-k8s/code-generator/pkg/testutil"
+)
+
+// verifyTemplateBasePath is the path to the templates, relative to this
+// package
+const verifyTemplateBasePath = "../../../templates"
+
+// verifyExpectedFailures maps the testdata services whose generated
+// controller is known not to type-check to the reason why. None of these
+// services generated a working controller before `Verify` existed either;
+// remove an entry once the service verifies cleanly.
+var verifyExpectedFailures = map[string]string{
+	// customCreateApi and customUpdateApi are hand-written in the real
+	// controller and don't exist in the generated package
+	"apigatewayv2": "missing hand-written custom methods",
+	// the AutoScalingGroups list of strings is set from the output with the
+	// wrong element type
+	"codedeploy": "wrong element type setting a list of strings",
+	// the Custom* methods and equalEngineVersion are hand-written, and the
+	// testdata model is newer than the aws-sdk-go version in go.mod
+	"elasticache": "missing hand-written code and model drift",
+	// rendering the ReadOne input panics on a nil operation
+	"lambda": "ReadOne input can't be rendered",
+	// the generator.yaml refers to a hook template that isn't in testdata
+	"mq": "missing hook template",
+	// the testdata model is newer than the aws-sdk-go version in go.mod
+	"sagemaker": "model drift",
+}
+
+func TestVerify_ControllerTypeChecks(t *testing.T) {
+	require := require.New(t)
+
+	svcDirs, err := ioutil.ReadDir(
+		filepath.Join(testutil.TestdataPath(), "models", "apis"),
+	)
+	require.Nil(err)
+	for _, svcDir := range svcDirs {
+		svcAlias := svcDir.Name()
+		t.Run(svcAlias, func(t *testing.T) {
+			g := testutil.NewGeneratorForService(t, svcAlias)
+			verrs, err := verifyController(g)
+			reason, expectFailure := verifyExpectedFailures[svcAlias]
+			if expectFailure {
+				if err == nil && len(verrs) == 0 {
+					t.Errorf(
+						"%s verifies cleanly, remove its expected failure (%s)",
+						svcAlias, reason,
+					)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, verr := range verrs {
+				t.Error(verr)
+			}
+		})
+	}
+}
+
+// verifyController generates the API types and the controller for the
+// supplied generator and returns the type errors in the generated code.
+// Unlike testutil.VerifyController, failing to generate the code, including
+// a generation panic, is returned as an error instead of failing the test.
+func verifyController(
+	g *generate.Generator,
+) (verrs []*ack.VerifyError, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+	templateBasePaths := []string{verifyTemplateBasePath}
+	apis, err := ack.APIs(g, templateBasePaths)
+	if err != nil {
+		return nil, err
+	}
+	if err = apis.Execute(); err != nil {
+		return nil, err
+	}
+	controller, err := ack.Controller(g, templateBasePaths)
+	if err != nil {
+		return nil, err
+	}
+	if err = controller.Execute(); err != nil {
+		return nil, err
+	}
+	return ack.Verify(g, apis, controller, "")
+}
+
+func TestVerify_ErrorOrigin(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	// Override the manager factory template with one declaring a variable
+	// of the wrong type
+	overrideDir, err := ioutil.TempDir("", "verify")
+	require.Nil(err)
+	defer os.RemoveAll(overrideDir)
+	tplPath := filepath.Join("pkg", "resource", "manager_factory.go.tpl")
+	tplContents, err := ioutil.ReadFile(
+		filepath.Join(verifyTemplateBasePath, tplPath),
+	)
+	require.Nil(err)
+	overridePath := filepath.Join(overrideDir, tplPath)
+	require.Nil(os.MkdirAll(filepath.Dir(overridePath), os.ModePerm))
+	tplContents = append(tplContents, []byte("\nvar _ int = \"verify\"\n")...)
+	require.Nil(ioutil.WriteFile(overridePath, tplContents, 0666))
+
+	g := testutil.NewGeneratorForService(t, "ecr")
+	verrs := testutil.VerifyController(
+		t, g, []string{overrideDir, verifyTemplateBasePath},
+	)
+	require.Len(verrs, 1)
+	verr := verrs[0]
+	assert.Equal("pkg/resource/repository/manager_factory.go", verr.Path)
+	assert.Contains(verr.Message, `"verify"`)
+	assert.Equal(overridePath, verr.Template)
+	assert.Equal("Repository", verr.CRD)
+	assert.Contains(verr.Error(), "(template "+overridePath+", CRD Repository)")
+}
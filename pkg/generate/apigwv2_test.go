@@ -37,6 +37,15 @@ func TestAPIGatewayV2_GetTypeDefs(t *testing.T) {
 	require.NotNil(tdef)
 
 	assert.Equal("API_SDK", tdef.Names.Camel)
+
+	// The Stage Shape's RouteSettings member is a map of RouteSettings
+	// structs, keyed by route
+	tdef = testutil.GetTypeDefByName(t, g, "Stage")
+	require.NotNil(tdef)
+
+	attr := tdef.Attrs["RouteSettings"]
+	require.NotNil(attr)
+	assert.Equal("map[string]*RouteSettings", attr.GoType)
 }
 
 func TestAPIGatewayV2_Api(t *testing.T) {
@@ -174,4 +183,47 @@ func TestAPIGatewayV2_Route(t *testing.T) {
 		"RouteID",
 	}
 	assert.Equal(expStatusFieldCamel, attrCamelNames(statusFields))
+
+	// A Route is identified by both the ApiId and the RouteId, as configured
+	// with `identifier_part` in the generator config
+	idFields := crd.IdentifierFields()
+	require.Len(idFields, 2)
+	assert.Equal("APIID", idFields[0].Names.Camel)
+	assert.Equal("RouteID", idFields[1].Names.Camel)
+	assert.True(crd.HasCompositeIdentifier())
+	require.NotNil(crd.SpecIdentifierField())
+	assert.Equal("APIID", *crd.SpecIdentifierField())
+	// AWSIdentifiers can't carry the RouteId, so a Route can't be adopted
+	assert.False(crd.IsAdoptable())
+
+	// A Route only exists within an Api, as configured with the `parent`
+	// block in the generator config
+	require.True(crd.HasParent())
+	assert.Equal("Api", crd.Parent().Names.Original)
+	assert.Equal("APIID", crd.ParentField().Names.Camel)
+	assert.Equal("Status.APIID", crd.ParentIdentifierFieldPath())
+	assert.Equal("APIRef", crd.ParentRefFieldNames().Camel)
+	assert.Equal("apiRef", crd.ParentRefFieldNames().CamelLower)
+	// The parent field can be populated from the parent reference, so it
+	// is no longer required
+	assert.False(specFields["ApiId"].IsRequired())
+
+	printerColumns := crd.AdditionalPrinterColumns()
+	require.Len(printerColumns, 1)
+	assert.Equal("API", printerColumns[0].Name)
+	assert.Equal("string", printerColumns[0].Type)
+	assert.Equal(".spec.apiID", printerColumns[0].JSONPath)
+
+	api := getCRDByName("Api", crds)
+	require.NotNil(api)
+	assert.False(api.HasParent())
+	// The Api's resource manager refuses to delete the Api while Routes
+	// still refer to it
+	require.True(api.HasChildren())
+	require.Len(api.Children(), 1)
+	assert.Equal(crd, api.Children()[0])
+	assert.False(crd.HasChildren())
+	// Both resource managers need a Kubernetes API client
+	assert.True(crd.UsesKubeClient())
+	assert.True(api.UsesKubeClient())
 }
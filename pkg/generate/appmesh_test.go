@@ -0,0 +1,85 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	 http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package generate_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ackmodel "github.com/aws-controllers-k8s/code-generator/pkg/model"
+	"github.com/aws-controllers-k8s/code-generator/pkg/testutil"
+)
+
+func TestAppMesh_VirtualService_Unions(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewGeneratorForService(t, "appmesh")
+
+	crds, err := g.GetCRDs()
+	require.Nil(err)
+
+	crd := getCRDByName("VirtualService", crds)
+	require.NotNil(crd)
+
+	// The VirtualServiceSpec shape contains a single `provider` member of
+	// shape VirtualServiceProvider, which is marked `"union": true` in the
+	// API model:
+	//
+	//    "VirtualServiceProvider":{
+	//      "type":"structure",
+	//      "members":{
+	//        "virtualNode":{"shape":"VirtualNodeServiceProvider"},
+	//        "virtualRouter":{"shape":"VirtualRouterServiceProvider"}
+	//      },
+	//      "union":true
+	//    },
+	specField := crd.SpecFields["Spec"]
+	require.NotNil(specField)
+	assert.False(specField.IsUnion())
+
+	providerField := crd.Fields["Spec.Provider"]
+	require.NotNil(providerField)
+	assert.True(providerField.IsUnion())
+
+	tdefs, err := g.GetTypeDefs()
+	require.Nil(err)
+
+	var providerTypeDef, specTypeDef *ackmodel.TypeDef
+	for _, tdef := range tdefs {
+		switch tdef.Names.Original {
+		case "VirtualServiceProvider":
+			providerTypeDef = tdef
+		case "VirtualServiceSpec":
+			specTypeDef = tdef
+		}
+	}
+	require.NotNil(providerTypeDef)
+	require.NotNil(specTypeDef)
+
+	assert.True(providerTypeDef.IsUnion)
+	assert.Equal(
+		`+kubebuilder:validation:XValidation:rule="(has(self.virtualNode) ? 1 : 0) + (has(self.virtualRouter) ? 1 : 0) == 1",message="exactly one of virtualNode, virtualRouter must be set"`,
+		providerTypeDef.UnionValidationMarker(),
+	)
+	// The CEL rule is only emitted when the generator config sets
+	// `union_validation`
+	unvalidated := *providerTypeDef
+	unvalidated.ValidateUnion = false
+	assert.Equal("", unvalidated.UnionValidationMarker())
+	assert.False(specTypeDef.IsUnion)
+	assert.Equal("", specTypeDef.UnionValidationMarker())
+}
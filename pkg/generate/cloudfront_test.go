@@ -0,0 +1,138 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	 http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package generate_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/generate/config"
+	"github.com/aws-controllers-k8s/code-generator/pkg/testutil"
+)
+
+func TestCloudFront_CachePolicy(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewGeneratorForService(t, "cloudfront")
+
+	crds, err := g.GetCRDs()
+	require.Nil(err)
+
+	crd := getCRDByName("CachePolicy", crds)
+	require.NotNil(crd)
+
+	// CreateCachePolicyInput has a single CachePolicyConfig member, which is
+	// unwrapped automatically so that its members are top-level Spec fields
+	assert.Equal("CachePolicyConfig", crd.UnwrappedInputMember())
+
+	specFields := crd.SpecFields
+	statusFields := crd.StatusFields
+
+	expSpecFieldCamel := []string{
+		"Comment",
+		"DefaultTTL",
+		"MaxTTL",
+		"MinTTL",
+		"Name",
+		"ParametersInCacheKeyAndForwardedToOrigin",
+	}
+	assert.Equal(expSpecFieldCamel, attrCamelNames(specFields))
+
+	expStatusFieldCamel := []string{
+		"CachePolicy",
+		"ETag",
+		// Added from generator.yaml
+		"ID",
+		"Location",
+	}
+	assert.Equal(expStatusFieldCamel, attrCamelNames(statusFields))
+
+	// The required members of the wrapper shape are required Spec fields
+	assert.True(specFields["Name"].IsRequired())
+	assert.True(specFields["MinTTL"].IsRequired())
+	assert.False(specFields["Comment"].IsRequired())
+
+	// The generator.yaml configures the field through the wrapper member,
+	// i.e. CachePolicyConfig.Comment
+	commentConfig := specFields["Comment"].FieldConfig
+	require.NotNil(commentConfig)
+	require.NotNil(commentConfig.Compare)
+	assert.True(commentConfig.Compare.IsIgnored)
+}
+
+func TestCloudFront_TrimResourceFieldPathPrefix(t *testing.T) {
+	assert := assert.New(t)
+
+	cfg := &ackgenconfig.Config{
+		Resources: map[string]ackgenconfig.ResourceConfig{
+			"CachePolicy": {
+				Fields: map[string]*ackgenconfig.FieldConfig{
+					"CachePolicyConfig.Comment": {},
+				},
+				Compare: &ackgenconfig.CompareConfig{
+					Ignore: []string{
+						"Spec.CachePolicyConfig.MinTTL",
+						"CachePolicyConfig.MaxTTL",
+					},
+				},
+			},
+		},
+	}
+	cfg.TrimResourceFieldPathPrefix("CachePolicy", "CachePolicyConfig")
+
+	rConfig := cfg.Resources["CachePolicy"]
+	assert.Contains(rConfig.Fields, "Comment")
+	assert.NotContains(rConfig.Fields, "CachePolicyConfig.Comment")
+	assert.Equal(
+		[]string{"Spec.MinTTL", "MaxTTL"}, rConfig.Compare.Ignore,
+	)
+}
+
+func TestCloudFront_TrimResourceFieldPathPrefix_Collisions(t *testing.T) {
+	assert := assert.New(t)
+
+	fieldsCfg := &ackgenconfig.Config{
+		Resources: map[string]ackgenconfig.ResourceConfig{
+			"CachePolicy": {
+				Fields: map[string]*ackgenconfig.FieldConfig{
+					"CachePolicyConfig.Comment": {},
+					"Comment":                   {},
+				},
+			},
+		},
+	}
+	assert.Panics(func() {
+		fieldsCfg.TrimResourceFieldPathPrefix("CachePolicy", "CachePolicyConfig")
+	})
+
+	// "Spec." is optional in compare.ignore, so both paths refer to MinTTL
+	ignoreCfg := &ackgenconfig.Config{
+		Resources: map[string]ackgenconfig.ResourceConfig{
+			"CachePolicy": {
+				Compare: &ackgenconfig.CompareConfig{
+					Ignore: []string{
+						"Spec.CachePolicyConfig.MinTTL",
+						"MinTTL",
+					},
+				},
+			},
+		},
+	}
+	assert.Panics(func() {
+		ignoreCfg.TrimResourceFieldPathPrefix("CachePolicy", "CachePolicyConfig")
+	})
+}
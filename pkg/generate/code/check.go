@@ -22,6 +22,7 @@ import (
 	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/generate/config"
 	"github.com/aws-controllers-k8s/code-generator/pkg/model"
 	"github.com/aws-controllers-k8s/code-generator/pkg/names"
+	"github.com/aws-controllers-k8s/code-generator/pkg/util"
 )
 
 // CheckExceptionMessage returns Go code that contains a condition to
@@ -81,13 +82,44 @@ func CheckRequiredFieldsMissingFromShape(
 	default:
 		return ""
 	}
+	return checkRequiredFieldsMissingFromInput(r, op, koVarName, indentLevel)
+}
 
+// checkRequiredFieldsMissingFromInput returns Go code that contains a
+// condition checking that the required fields in the Input shape of the
+// supplied operation have a non-nil value in the corresponding CR's Spec or
+// Status substruct. See CheckRequiredFieldsMissingFromShape.
+func checkRequiredFieldsMissingFromInput(
+	r *model.CRD,
+	op *awssdkmodel.Operation,
+	koVarName string,
+	indentLevel int,
+) string {
 	shape := op.InputRef.Shape
+	memberNames := []string{}
+	if shape != nil {
+		memberNames = append(memberNames, shape.Required...)
+	}
+	if shape != nil && op == r.Ops.ReadOne {
+		// All parts of a composite identifier need to be set before we can
+		// look up the resource, even when the API marks some of them as
+		// optional.
+		for _, f := range r.IdentifierFields() {
+			memberName := f.Names.Original
+			if _, found := shape.MemberRefs[memberName]; !found {
+				continue
+			}
+			if !util.InStrings(memberName, memberNames) {
+				memberNames = append(memberNames, memberName)
+			}
+		}
+	}
 	return checkRequiredFieldsMissingFromShape(
 		r,
 		koVarName,
 		indentLevel,
 		shape,
+		memberNames,
 	)
 }
 
@@ -96,9 +128,11 @@ func checkRequiredFieldsMissingFromShape(
 	koVarName string,
 	indentLevel int,
 	shape *awssdkmodel.Shape,
+	// The names of the members of the shape that must be set
+	memberNames []string,
 ) string {
 	indent := strings.Repeat("\t", indentLevel)
-	if shape == nil || len(shape.Required) == 0 {
+	if shape == nil || len(memberNames) == 0 {
 		return fmt.Sprintf("%sreturn false", indent)
 	}
 
@@ -107,7 +141,7 @@ func checkRequiredFieldsMissingFromShape(
 	// generate an if condition checking for all required fields having non-nil
 	// corresponding resource Spec/Status values
 	missing := []string{}
-	for _, memberName := range shape.Required {
+	for _, memberName := range memberNames {
 		if r.UnpacksAttributesMap() {
 			// We set the Attributes field specially... depending on whether
 			// the SetAttributes API call uses the batch or single attribute
@@ -122,6 +156,10 @@ func checkRequiredFieldsMissingFromShape(
 				}
 			}
 		}
+		if r.IsSingletonAccountIDField(memberName) {
+			// Always set from the AWS account ID of the resource manager
+			continue
+		}
 		if r.IsPrimaryARNField(memberName) {
 			primaryARNCondition := fmt.Sprintf(
 				"(%s.Status.ACKResourceMetadata == nil || %s.Status.ACKResourceMetadata.ARN == nil)",
@@ -153,6 +191,9 @@ func checkRequiredFieldsMissingFromShape(
 		}
 		missing = append(missing, fmt.Sprintf("%s == nil", resVarPath))
 	}
+	if len(missing) == 0 {
+		return fmt.Sprintf("%sreturn false", indent)
+	}
 	// Use '||' because if any of the required fields are missing the object
 	// is not created yet
 	missingCondition := strings.Join(missing, " || ")
@@ -94,3 +94,26 @@ func TestCheckRequiredFields_Attributes_StatusAndSpecField(t *testing.T) {
 		strings.TrimSpace(gotCode),
 	)
 }
+
+func TestCheckRequiredFields_SingletonAccountID(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewGeneratorForService(t, "s3control")
+
+	crd := testutil.GetCRDByName(t, g, "PublicAccessBlock")
+	require.NotNil(crd)
+
+	// The GetPublicAccessBlockRequest shape's only required member is the
+	// AccountId of the singleton resource, which is never missing
+	expRequiredFieldsCode := `
+	return false
+`
+	gotCode := code.CheckRequiredFieldsMissingFromShape(
+		crd, model.OpTypeGet, "r.ko", 1,
+	)
+	assert.Equal(
+		strings.TrimSpace(expRequiredFieldsCode),
+		strings.TrimSpace(gotCode),
+	)
+}
@@ -16,6 +16,7 @@ package code
 import (
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 
 	awssdkmodel "github.com/aws/aws-sdk-go/private/model/api"
@@ -79,19 +80,40 @@ func CompareResource(
 			compareConfig = fieldConfig.Compare
 		}
 
-		if compareConfig != nil && compareConfig.IsIgnored {
+		// this is the "path" to the field within the structs being compared.
+		// This is passed down into the compareXXX functions recursively and
+		// appended to with each level of nested structs, list elements and
+		// map values we recurse into.
+		fieldPath := comparePath{
+			delta: strings.TrimPrefix(
+				cfg.PrefixConfig.SpecField+"."+specField.Names.Camel, ".",
+			),
+			config: fieldName,
+		}
+
+		// Fields are ignored either with `compare.is_ignored` in their field
+		// config or with the resource's `compare.ignore` paths
+		if r.IsCompareIgnoredPath(fieldPath.config) {
 			continue
 		}
 
-		// this is the "path" to the field within the structs being compared.
-		// This is passed down into the compareXXX functions recursively and
-		// appended to with each level of nested structs we recurse into.
-		fieldPath := strings.TrimPrefix(
-			cfg.PrefixConfig.SpecField+"."+specField.Names.Camel, ".",
-		)
+		if specField.IsJSON() {
+			out += compareJSON(
+				deltaVarName,
+				firstResAdaptedVarName,
+				secondResAdaptedVarName,
+				fieldPath,
+				indentLevel,
+			)
+			continue
+		}
 
-		memberShapeRef := specField.ShapeRef
-		memberShape := memberShapeRef.Shape
+		// Fields unpacked from an Attributes map have no shape and are
+		// always strings
+		memberShape := &awssdkmodel.Shape{Type: "string"}
+		if specField.ShapeRef != nil {
+			memberShape = specField.ShapeRef.Shape
+		}
 
 		// if ackcompare.HasNilDifference(a.ko.Spec.Name, b.ko.Spec.Name == nil) {
 		//   delta.Add("Spec.Name", a.ko.Spec.Name, b.ko.Spec.Name)
@@ -117,8 +139,23 @@ func CompareResource(
 			out += "\n"
 		}
 
-		switch memberShape.Type {
-		case "structure":
+		comparator := model.ComparatorFunc(
+			memberShape, compareConfig, fieldPath.config,
+		)
+		switch {
+		case comparator != "":
+			//   if !equalCaseInsensitive(a.ko.Spec.Engine, b.ko.Spec.Engine) {
+			//     delta.Add("Spec.Engine", a.ko.Spec.Engine, b.ko.Spec.Engine)
+			//   }
+			out += compareWithComparator(
+				comparator,
+				deltaVarName,
+				firstResAdaptedVarName,
+				secondResAdaptedVarName,
+				fieldPath,
+				indentLevel,
+			)
+		case memberShape.Type == "structure":
 			// Recurse through all the struct's fields and subfields, building
 			// nested conditionals and calls to `delta.Add()`...
 			out += compareStruct(
@@ -131,7 +168,7 @@ func CompareResource(
 				fieldPath,
 				indentLevel,
 			)
-		case "list":
+		case memberShape.Type == "list":
 			// Returns Go code that compares all the elements of the slice fields...
 			out += compareSlice(
 				cfg, r,
@@ -143,7 +180,7 @@ func CompareResource(
 				fieldPath,
 				indentLevel,
 			)
-		case "map":
+		case memberShape.Type == "map":
 			// Returns Go code that compares all the elements of the map fields...
 			out += compareMap(
 				cfg, r,
@@ -206,10 +243,8 @@ func compareNil(
 	// CR under comparison. This will typically be something like
 	// "b.ko.Spec.Name". See `templates/pkg/resource/delta.go.tpl`.
 	secondResVarName string,
-	// String indicating the current field path being evaluated, e.g.
-	// "Author.Name". This does not include the top-level Spec or Status
-	// struct.
-	fieldPath string,
+	// The current field path being evaluated, e.g. "Spec.Author.Name"
+	fieldPath comparePath,
 	// Number of levels of indentation to use
 	indentLevel int,
 ) string {
@@ -233,8 +268,8 @@ func compareNil(
 	}
 	//   delta.Add("Spec.Name", a.ko.Spec.Name, b.ko.Spec.Name)
 	out += fmt.Sprintf(
-		"%s\t%s.Add(\"%s\", %s, %s)\n",
-		indent, deltaVarName, fieldPath, firstResVarName, secondResVarName,
+		"%s\t%s.Add(%s, %s, %s)\n",
+		indent, deltaVarName, fieldPath.goExpr(), firstResVarName, secondResVarName,
 	)
 	// }
 	out += fmt.Sprintf(
@@ -244,6 +279,65 @@ func compareNil(
 	return out
 }
 
+// compareJSON outputs Go code that compares two JSON document values from two
+// resource fields and, if there is a difference, adds the difference to a
+// variable representing an `ackcompare.Delta`. The JSON documents are
+// compared semantically, so differences in key order and whitespace are not
+// differences.
+//
+// Output code will look something like this:
+//
+//   if ackcompare.HasNilDifference(a.ko.Spec.Policy, b.ko.Spec.Policy) {
+//     delta.Add("Spec.Policy", a.ko.Spec.Policy, b.ko.Spec.Policy)
+//   } else if a.ko.Spec.Policy != nil && b.ko.Spec.Policy != nil {
+//     if !equalJSON(a.ko.Spec.Policy.Raw, b.ko.Spec.Policy.Raw) {
+//       delta.Add("Spec.Policy", a.ko.Spec.Policy, b.ko.Spec.Policy)
+//     }
+//   }
+func compareJSON(
+	// String representing the name of the variable that is of type
+	// `*ackcompare.Delta`. We will generate Go code that calls the `Add()`
+	// method of this variable when differences between fields are detected.
+	deltaVarName string,
+	// String representing the name of the variable that represents the first
+	// CR under comparison. This will typically be something like
+	// "a.ko.Spec.Policy". See `templates/pkg/resource/delta.go.tpl`.
+	firstResVarName string,
+	// String representing the name of the variable that represents the second
+	// CR under comparison. This will typically be something like
+	// "b.ko.Spec.Policy". See `templates/pkg/resource/delta.go.tpl`.
+	secondResVarName string,
+	// The current field path being evaluated, e.g. "Spec.Policy"
+	fieldPath comparePath,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+	addDelta := fmt.Sprintf(
+		"%s.Add(%s, %s, %s)\n",
+		deltaVarName, fieldPath.goExpr(), firstResVarName, secondResVarName,
+	)
+
+	out += fmt.Sprintf(
+		"%sif ackcompare.HasNilDifference(%s, %s) {\n",
+		indent, firstResVarName, secondResVarName,
+	)
+	out += fmt.Sprintf("%s\t%s", indent, addDelta)
+	out += fmt.Sprintf(
+		"%s} else if %s != nil && %s != nil {\n",
+		indent, firstResVarName, secondResVarName,
+	)
+	out += fmt.Sprintf(
+		"%s\tif !equalJSON(%s.Raw, %s.Raw) {\n",
+		indent, firstResVarName, secondResVarName,
+	)
+	out += fmt.Sprintf("%s\t\t%s", indent, addDelta)
+	out += fmt.Sprintf("%s\t}\n", indent)
+	out += fmt.Sprintf("%s}\n", indent)
+	return out
+}
+
 // compareScalar outputs Go code that compares two scalar values from two
 // resource fields and, if there is a difference, adds the difference to a
 // variable representing an `ackcompare.Delta`.
@@ -270,10 +364,8 @@ func compareScalar(
 	// CR under comparison. This will typically be something like
 	// "b.ko.Spec.Name". See `templates/pkg/resource/delta.go.tpl`.
 	secondResVarName string,
-	// String indicating the current field path being evaluated, e.g.
-	// "Author.Name". This does not include the top-level Spec or Status
-	// struct.
-	fieldPath string,
+	// The current field path being evaluated, e.g. "Spec.Author.Name"
+	fieldPath comparePath,
 	// Number of levels of indentation to use
 	indentLevel int,
 ) string {
@@ -293,13 +385,22 @@ func compareScalar(
 			"%sif !%s.Equal(%s) {\n",
 			indent, firstResVarName, secondResVarName,
 		)
+	case "blob":
+		// Blobs have no nilability test, so they are compared as slices of
+		// bytes
+		//
+		// if !reflect.DeepEqual(a.ko.Spec.Code.ZipFile, b.ko.Spec.Code.ZipFile) {
+		out += fmt.Sprintf(
+			"%sif !reflect.DeepEqual(%s, %s) {\n",
+			indent, firstResVarName, secondResVarName,
+		)
 	default:
 		panic("Unsupported shape type in generate.code.compareScalar: " + shape.Type)
 	}
 	//   delta.Add("Spec.Name", a.ko.Spec.Name, b.ko.Spec.Name)
 	out += fmt.Sprintf(
-		"%s\t%s.Add(\"%s\", %s, %s)\n",
-		indent, deltaVarName, fieldPath, firstResVarName, secondResVarName,
+		"%s\t%s.Add(%s, %s, %s)\n",
+		indent, deltaVarName, fieldPath.goExpr(), firstResVarName, secondResVarName,
 	)
 	// }
 	out += fmt.Sprintf(
@@ -309,6 +410,58 @@ func compareScalar(
 	return out
 }
 
+// compareWithComparator outputs Go code that compares two values from two
+// resource fields by calling the comparator function configured for the field
+// with `compare.comparator` and, if the function does not consider the values
+// equal, adds the difference to a variable representing an
+// `ackcompare.Delta`. The comparator is either one of the built-in
+// comparators of `templates/pkg/resource/delta.go.tpl` or a custom function
+// in the controller's resource package.
+//
+// Output code will look something like this:
+//
+//   if !equalCaseInsensitive(a.ko.Spec.Engine, b.ko.Spec.Engine) {
+//     delta.Add("Spec.Engine", a.ko.Spec.Engine, b.ko.Spec.Engine)
+//   }
+func compareWithComparator(
+	// name of the Go function comparing the field values
+	comparator string,
+	// String representing the name of the variable that is of type
+	// `*ackcompare.Delta`. We will generate Go code that calls the `Add()`
+	// method of this variable when differences between fields are detected.
+	deltaVarName string,
+	// String representing the name of the variable that represents the first
+	// CR under comparison. This will typically be something like
+	// "a.ko.Spec.Engine". See `templates/pkg/resource/delta.go.tpl`.
+	firstResVarName string,
+	// String representing the name of the variable that represents the second
+	// CR under comparison. This will typically be something like
+	// "b.ko.Spec.Engine". See `templates/pkg/resource/delta.go.tpl`.
+	secondResVarName string,
+	// The current field path being evaluated, e.g. "Spec.Engine"
+	fieldPath comparePath,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+	// if !equalCaseInsensitive(a.ko.Spec.Engine, b.ko.Spec.Engine) {
+	out += fmt.Sprintf(
+		"%sif !%s(%s, %s) {\n",
+		indent, comparator, firstResVarName, secondResVarName,
+	)
+	//   delta.Add("Spec.Engine", a.ko.Spec.Engine, b.ko.Spec.Engine)
+	out += fmt.Sprintf(
+		"%s\t%s.Add(%s, %s, %s)\n",
+		indent, deltaVarName, fieldPath.goExpr(), firstResVarName, secondResVarName,
+	)
+	// }
+	out += fmt.Sprintf(
+		"%s}\n", indent,
+	)
+	return out
+}
+
 // compareMap outputs Go code that compares two map values from two resource
 // fields and, if there is a difference, adds the difference to a variable
 // representing an `ackcompare.Delta`.
@@ -318,6 +471,9 @@ func compareScalar(
 //   if !ackcompare.MapStringStringPEqual(a.ko.Spec.Tags, b.ko.Spec.Tags) {
 //     delta.Add("Spec.Tags", a.ko.Spec.Tags, b.ko.Spec.Tags)
 //   }
+//
+// Maps with struct values are compared value by value. See
+// compareMapOfStruct.
 func compareMap(
 	cfg *ackgenconfig.Config,
 	r *model.CRD,
@@ -337,10 +493,8 @@ func compareMap(
 	// CR under comparison. This will typically be something like
 	// "b.ko.Spec.Name". See `templates/pkg/resource/delta.go.tpl`.
 	secondResVarName string,
-	// String indicating the current field path being evaluated, e.g.
-	// "Author.Name". This does not include the top-level Spec or Status
-	// struct.
-	fieldPath string,
+	// The current field path being evaluated, e.g. "Spec.Author.Name"
+	fieldPath comparePath,
 	// Number of levels of indentation to use
 	indentLevel int,
 ) string {
@@ -348,32 +502,41 @@ func compareMap(
 	indent := strings.Repeat("\t", indentLevel)
 
 	keyType := shape.KeyRef.Shape.Type
-
-	if keyType != "string" {
-		panic("generate.code.compareMap cannot deal with non-string key types: " + keyType)
-	}
-
 	valType := shape.ValueRef.Shape.Type
 
-	switch valType {
-	case "string":
+	switch {
+	case valType == "string" && keyType == "string":
 		// if !ackcompare.MapStringStringPEqual(a.ko.Spec.Tags, b.ko.Spec.Tags) {
 		out += fmt.Sprintf(
 			"%sif !ackcompare.MapStringStringPEqual(%s, %s) {\n",
 			indent, firstResVarName, secondResVarName,
 		)
-	case "structure":
-		// TODO(jaypipes): Implement this by walking the keys and struct values
-		// and comparing each struct individually, building up the fieldPath
-		// appropriately...
-		return ""
+	case valType == "structure":
+		// Walk the keys of both maps and compare each struct value
+		// individually, recording differences at the path of the value
+		return compareMapOfStruct(
+			cfg, r,
+			shape,
+			deltaVarName,
+			firstResVarName,
+			secondResVarName,
+			fieldPath,
+			indentLevel,
+		)
 	default:
-		panic("Unsupported shape type in generate.code.compareMap: " + shape.Type)
+		// Maps of non-string scalars and maps of lists or maps, e.g.
+		// map[string][]*string, are compared by walking all of their values
+		//
+		// if !reflect.DeepEqual(a.ko.Spec.Endpoints, b.ko.Spec.Endpoints) {
+		out += fmt.Sprintf(
+			"%sif !reflect.DeepEqual(%s, %s) {\n",
+			indent, firstResVarName, secondResVarName,
+		)
 	}
 	//   delta.Add("Spec.Name", a.ko.Spec.Name, b.ko.Spec.Name)
 	out += fmt.Sprintf(
-		"%s\t%s.Add(\"%s\", %s, %s)\n",
-		indent, deltaVarName, fieldPath, firstResVarName, secondResVarName,
+		"%s\t%s.Add(%s, %s, %s)\n",
+		indent, deltaVarName, fieldPath.goExpr(), firstResVarName, secondResVarName,
 	)
 	// }
 	out += fmt.Sprintf(
@@ -392,6 +555,12 @@ func compareMap(
 //   if !ackcompare.SliceStringPEqual(a.ko.Spec.SecurityGroupIDs, b.ko.Spec.SecurityGroupIDs) {
 //     delta.Add("Spec.SecurityGroupIDs", a.ko.Spec.SecurityGroupIDs, b.ko.Spec.SecurityGroupIDs)
 //   }
+//
+// Slices of structs are compared element by element. See
+// compareSliceOfStruct and, for slices whose elements are matched by the
+// `compare.key_fields` config, compareKeyedSliceOfStruct. Slices configured
+// with `compare.unordered` are compared as sets using the `equalUnordered`
+// function of `templates/pkg/resource/delta.go.tpl`.
 func compareSlice(
 	cfg *ackgenconfig.Config,
 	r *model.CRD,
@@ -411,10 +580,8 @@ func compareSlice(
 	// CR under comparison. This will typically be something like
 	// "b.ko.Spec.Name". See `templates/pkg/resource/delta.go.tpl`.
 	secondResVarName string,
-	// String indicating the current field path being evaluated, e.g.
-	// "Author.Name". This does not include the top-level Spec or Status
-	// struct.
-	fieldPath string,
+	// The current field path being evaluated, e.g. "Spec.Author.Name"
+	fieldPath comparePath,
 	// Number of levels of indentation to use
 	indentLevel int,
 ) string {
@@ -422,26 +589,60 @@ func compareSlice(
 	indent := strings.Repeat("\t", indentLevel)
 
 	elemType := shape.MemberRef.Shape.Type
+	keyFields := model.ListKeyFields(shape, compareConfig, fieldPath.config)
 
-	switch elemType {
-	case "string":
+	switch {
+	case len(keyFields) > 0:
+		// Match the elements of both slices by their key fields and compare
+		// each pair of matched struct elements individually
+		return compareKeyedSliceOfStruct(
+			cfg, r,
+			shape,
+			keyFields,
+			deltaVarName,
+			firstResVarName,
+			secondResVarName,
+			fieldPath,
+			indentLevel,
+		)
+	case model.IsUnorderedList(shape, compareConfig, fieldPath.config):
+		// if !equalUnordered(a.ko.Spec.SecurityGroupIDs, b.ko.Spec.SecurityGroupIDs) {
+		out += fmt.Sprintf(
+			"%sif !equalUnordered(%s, %s) {\n",
+			indent, firstResVarName, secondResVarName,
+		)
+	case elemType == "string":
 		// if !ackcompare.SliceStringPEqual(a.ko.Spec.SecurityGroupIDs, b.ko.Spec.SecurityGroupIDs) {
 		out += fmt.Sprintf(
 			"%sif !ackcompare.SliceStringPEqual(%s, %s) {\n",
 			indent, firstResVarName, secondResVarName,
 		)
-	case "structure":
-		// TODO(jaypipes): Implement this by walking the slice of struct values
-		// and comparing each struct individually, building up the fieldPath
-		// appropriately...
-		return ""
+	case elemType == "structure":
+		// Walk the elements of both slices and compare each struct element
+		// individually, recording differences at the path of the element
+		return compareSliceOfStruct(
+			cfg, r,
+			shape,
+			deltaVarName,
+			firstResVarName,
+			secondResVarName,
+			fieldPath,
+			indentLevel,
+		)
 	default:
-		panic("Unsupported shape type in generate.code.compareSlice: " + shape.Type)
+		// Slices of non-string scalars and slices of lists or maps, e.g.
+		// [][]*string, are compared by walking all of their elements
+		//
+		// if !reflect.DeepEqual(a.ko.Spec.Ports, b.ko.Spec.Ports) {
+		out += fmt.Sprintf(
+			"%sif !reflect.DeepEqual(%s, %s) {\n",
+			indent, firstResVarName, secondResVarName,
+		)
 	}
 	//   delta.Add("Spec.SecurityGroupIDs", a.ko.Spec.SecurityGroupIDs, b.ko.Spec.SecurityGroupIDs)
 	out += fmt.Sprintf(
-		"%s\t%s.Add(\"%s\", %s, %s)\n",
-		indent, deltaVarName, fieldPath, firstResVarName, secondResVarName,
+		"%s\t%s.Add(%s, %s, %s)\n",
+		indent, deltaVarName, fieldPath.goExpr(), firstResVarName, secondResVarName,
 	)
 	// }
 	out += fmt.Sprintf(
@@ -473,10 +674,8 @@ func compareStruct(
 	// CR under comparison. This will typically be something like
 	// "b.ko.Spec.Name". See `templates/pkg/resource/delta.go.tpl`.
 	secondResVarName string,
-	// String indicating the current field path being evaluated, e.g.
-	// "Author.Name". This does not include the top-level Spec or Status
-	// struct.
-	fieldPath string,
+	// The current field path being evaluated, e.g. "Spec.Author.Name"
+	fieldPath comparePath,
 	// Number of levels of indentation to use
 	indentLevel int,
 ) string {
@@ -494,18 +693,29 @@ func compareStruct(
 		// this is the "path" to the field within the structs being compared.
 		// This is passed down into the compareXXX functions recursively and
 		// appended to with each level of nested structs we recurse into.
-		memberFieldPath := fieldPath + "." + memberNameClean
+		memberFieldPath := fieldPath.member(memberNameClean)
 		indent := strings.Repeat("\t", indentLevel)
 		firstResAdaptedVarName := firstResVarName + "." + memberNameClean
 		secondResAdaptedVarName := secondResVarName + "." + memberNameClean
 
 		var compareConfig *ackgenconfig.CompareFieldConfig
-		fieldConfig := fieldConfigs[memberFieldPath]
+		fieldConfig := fieldConfigs[memberFieldPath.config]
 		if fieldConfig != nil {
 			compareConfig = fieldConfig.Compare
 		}
 
-		if compareConfig != nil && compareConfig.IsIgnored {
+		if r.IsCompareIgnoredPath(memberFieldPath.config) {
+			continue
+		}
+
+		if memberShapeRef.JSONValue {
+			out += compareJSON(
+				deltaVarName,
+				firstResAdaptedVarName,
+				secondResAdaptedVarName,
+				memberFieldPath,
+				indentLevel,
+			)
 			continue
 		}
 
@@ -534,8 +744,20 @@ func compareStruct(
 		} else {
 			out += "\n"
 		}
-		switch memberShape.Type {
-		case "structure":
+		comparator := model.ComparatorFunc(
+			memberShape, compareConfig, memberFieldPath.config,
+		)
+		switch {
+		case comparator != "":
+			out += compareWithComparator(
+				comparator,
+				deltaVarName,
+				firstResAdaptedVarName,
+				secondResAdaptedVarName,
+				memberFieldPath,
+				indentLevel,
+			)
+		case memberShape.Type == "structure":
 			// Recurse through all the struct's fields and subfields, building
 			// nested conditionals and calls to `delta.Add()`...
 			out += compareStruct(
@@ -548,7 +770,7 @@ func compareStruct(
 				memberFieldPath,
 				indentLevel,
 			)
-		case "list":
+		case memberShape.Type == "list":
 			// Returns Go code that compares all the elements of the slice fields...
 			out += compareSlice(
 				cfg, r,
@@ -560,7 +782,7 @@ func compareStruct(
 				memberFieldPath,
 				indentLevel,
 			)
-		case "map":
+		case memberShape.Type == "map":
 			// Returns Go code that compares all the elements of the map fields...
 			out += compareMap(
 				cfg, r,
@@ -596,3 +818,494 @@ func compareStruct(
 	}
 	return out
 }
+
+// compareSliceOfStruct outputs Go code that compares two slices of struct
+// values from two resource fields element by element and adds the
+// differences between the elements to a variable representing an
+// `ackcompare.Delta`. Slices of different lengths are added to the delta as
+// a whole.
+//
+// Output code will look something like this:
+//
+//   if len(a.ko.Spec.Rules) != len(b.ko.Spec.Rules) {
+//     delta.Add("Spec.Rules", a.ko.Spec.Rules, b.ko.Spec.Rules)
+//   } else {
+//     for f0idx := range a.ko.Spec.Rules {
+//       f0a := a.ko.Spec.Rules[f0idx]
+//       f0b := b.ko.Spec.Rules[f0idx]
+//       if ackcompare.HasNilDifference(f0a, f0b) {
+//         delta.Add(fmt.Sprintf("Spec.Rules.%d", f0idx), f0a, f0b)
+//       } else if f0a != nil && f0b != nil {
+//         if ackcompare.HasNilDifference(f0a.Port, f0b.Port) {
+//           delta.Add(fmt.Sprintf("Spec.Rules.%d.Port", f0idx), f0a.Port, f0b.Port)
+//         } ...
+//       }
+//     }
+//   }
+func compareSliceOfStruct(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// struct describing the SDK type of the field being compared
+	shape *awssdkmodel.Shape,
+	// String representing the name of the variable that is of type
+	// `*ackcompare.Delta`. We will generate Go code that calls the `Add()`
+	// method of this variable when differences between fields are detected.
+	deltaVarName string,
+	// String representing the name of the variable that represents the first
+	// CR under comparison. This will typically be something like
+	// "a.ko.Spec.Rules". See `templates/pkg/resource/delta.go.tpl`.
+	firstResVarName string,
+	// String representing the name of the variable that represents the second
+	// CR under comparison. This will typically be something like
+	// "b.ko.Spec.Rules". See `templates/pkg/resource/delta.go.tpl`.
+	secondResVarName string,
+	// The current field path being evaluated, e.g. "Spec.Rules"
+	fieldPath comparePath,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+	// The loop variables are named after the number of lists and maps we are
+	// nested in so that nested loops do not shadow each other
+	prefix := fmt.Sprintf("f%d", len(fieldPath.args))
+	idxVarName := prefix + "idx"
+	firstElemVarName := prefix + "a"
+	secondElemVarName := prefix + "b"
+	elemPath := fieldPath.element("%d", idxVarName)
+
+	// if len(a.ko.Spec.Rules) != len(b.ko.Spec.Rules) {
+	out += fmt.Sprintf(
+		"%sif len(%s) != len(%s) {\n",
+		indent, firstResVarName, secondResVarName,
+	)
+	//   delta.Add("Spec.Rules", a.ko.Spec.Rules, b.ko.Spec.Rules)
+	out += fmt.Sprintf(
+		"%s\t%s.Add(%s, %s, %s)\n",
+		indent, deltaVarName, fieldPath.goExpr(), firstResVarName, secondResVarName,
+	)
+	// } else {
+	out += fmt.Sprintf("%s} else {\n", indent)
+	//   for f0idx := range a.ko.Spec.Rules {
+	out += fmt.Sprintf(
+		"%s\tfor %s := range %s {\n", indent, idxVarName, firstResVarName,
+	)
+	//     f0a := a.ko.Spec.Rules[f0idx]
+	//     f0b := b.ko.Spec.Rules[f0idx]
+	out += fmt.Sprintf(
+		"%s\t\t%s := %s[%s]\n",
+		indent, firstElemVarName, firstResVarName, idxVarName,
+	)
+	out += fmt.Sprintf(
+		"%s\t\t%s := %s[%s]\n",
+		indent, secondElemVarName, secondResVarName, idxVarName,
+	)
+	out += compareStructElement(
+		cfg, r,
+		shape.MemberRef.Shape,
+		deltaVarName,
+		firstElemVarName,
+		secondElemVarName,
+		elemPath,
+		indentLevel+2,
+	)
+	//   }
+	// }
+	out += fmt.Sprintf("%s\t}\n", indent)
+	out += fmt.Sprintf("%s}\n", indent)
+	return out
+}
+
+// compareKeyedSliceOfStruct outputs Go code that compares two slices of
+// struct values from two resource fields, matching the elements of the two
+// slices by the values of the supplied key fields instead of by index. The
+// differences between matched elements are added to a variable representing
+// an `ackcompare.Delta`, as are the elements that have no match in the other
+// slice. The paths of the differences identify the elements by the values of
+// their key fields, e.g. "Spec.Rules.tcp,80.Description".
+//
+// Output code will look something like this:
+//
+//   for _, f0a := range a.ko.Spec.Rules {
+//     f0key := elementKey(f0a, "Protocol", "Port")
+//     f0found := false
+//     for _, f0b := range b.ko.Spec.Rules {
+//       if !equalKeys(f0a, f0b, "Protocol", "Port") {
+//         continue
+//       }
+//       f0found = true
+//       if ackcompare.HasNilDifference(f0a.Description, f0b.Description) {
+//         delta.Add(fmt.Sprintf("Spec.Rules.%s.Description", f0key), f0a.Description, f0b.Description)
+//       } ...
+//       break
+//     }
+//     if !f0found {
+//       delta.Add(fmt.Sprintf("Spec.Rules.%s", f0key), f0a, nil)
+//     }
+//   }
+//   for _, f0b := range b.ko.Spec.Rules {
+//     f0found := false
+//     for _, f0a := range a.ko.Spec.Rules {
+//       if equalKeys(f0a, f0b, "Protocol", "Port") {
+//         f0found = true
+//         break
+//       }
+//     }
+//     if !f0found {
+//       delta.Add(fmt.Sprintf("Spec.Rules.%s", elementKey(f0b, "Protocol", "Port")), nil, f0b)
+//     }
+//   }
+func compareKeyedSliceOfStruct(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// struct describing the SDK type of the field being compared
+	shape *awssdkmodel.Shape,
+	// The Go names of the members of the struct elements that identify an
+	// element
+	keyFields []string,
+	// String representing the name of the variable that is of type
+	// `*ackcompare.Delta`. We will generate Go code that calls the `Add()`
+	// method of this variable when differences between fields are detected.
+	deltaVarName string,
+	// String representing the name of the variable that represents the first
+	// CR under comparison. This will typically be something like
+	// "a.ko.Spec.Rules". See `templates/pkg/resource/delta.go.tpl`.
+	firstResVarName string,
+	// String representing the name of the variable that represents the second
+	// CR under comparison. This will typically be something like
+	// "b.ko.Spec.Rules". See `templates/pkg/resource/delta.go.tpl`.
+	secondResVarName string,
+	// The current field path being evaluated, e.g. "Spec.Rules"
+	fieldPath comparePath,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+	prefix := fmt.Sprintf("f%d", len(fieldPath.args))
+	keyVarName := prefix + "key"
+	foundVarName := prefix + "found"
+	firstElemVarName := prefix + "a"
+	secondElemVarName := prefix + "b"
+
+	quotedKeyFields := make([]string, 0, len(keyFields))
+	for _, keyField := range keyFields {
+		quotedKeyFields = append(quotedKeyFields, strconv.Quote(keyField))
+	}
+	keyFieldArgs := strings.Join(quotedKeyFields, ", ")
+	// equalKeys(f0a, f0b, "Protocol", "Port")
+	equalKeysCall := fmt.Sprintf(
+		"equalKeys(%s, %s, %s)",
+		firstElemVarName, secondElemVarName, keyFieldArgs,
+	)
+	// The elements of the first slice are identified by the key held in
+	// f0key, those of the second slice that have no match by the key
+	// returned by elementKey(f0b, "Protocol", "Port")
+	elemPath := fieldPath.element("%s", keyVarName)
+	secondElemPath := fieldPath.element(
+		"%s",
+		fmt.Sprintf("elementKey(%s, %s)", secondElemVarName, keyFieldArgs),
+	)
+
+	// for _, f0a := range a.ko.Spec.Rules {
+	//   f0key := elementKey(f0a, "Protocol", "Port")
+	//   f0found := false
+	//   for _, f0b := range b.ko.Spec.Rules {
+	//     if !equalKeys(f0a, f0b, "Protocol", "Port") {
+	//       continue
+	//     }
+	//     f0found = true
+	out += fmt.Sprintf(
+		"%sfor _, %s := range %s {\n",
+		indent, firstElemVarName, firstResVarName,
+	)
+	out += fmt.Sprintf(
+		"%s\t%s := elementKey(%s, %s)\n",
+		indent, keyVarName, firstElemVarName, keyFieldArgs,
+	)
+	out += fmt.Sprintf("%s\t%s := false\n", indent, foundVarName)
+	out += fmt.Sprintf(
+		"%s\tfor _, %s := range %s {\n",
+		indent, secondElemVarName, secondResVarName,
+	)
+	out += fmt.Sprintf("%s\t\tif !%s {\n", indent, equalKeysCall)
+	out += fmt.Sprintf("%s\t\t\tcontinue\n", indent)
+	out += fmt.Sprintf("%s\t\t}\n", indent)
+	out += fmt.Sprintf("%s\t\t%s = true\n", indent, foundVarName)
+	// equalKeys is only true for two non-nil elements, so the members of
+	// the matched elements can be compared directly
+	out += compareStruct(
+		cfg, r,
+		nil,
+		shape.MemberRef.Shape,
+		deltaVarName,
+		firstElemVarName,
+		secondElemVarName,
+		elemPath,
+		indentLevel+2,
+	)
+	//     break
+	//   }
+	//   if !f0found {
+	//     delta.Add(fmt.Sprintf("Spec.Rules.%s", f0key), f0a, nil)
+	//   }
+	// }
+	out += fmt.Sprintf("%s\t\tbreak\n", indent)
+	out += fmt.Sprintf("%s\t}\n", indent)
+	out += fmt.Sprintf("%s\tif !%s {\n", indent, foundVarName)
+	out += fmt.Sprintf(
+		"%s\t\t%s.Add(%s, %s, nil)\n",
+		indent, deltaVarName, elemPath.goExpr(), firstElemVarName,
+	)
+	out += fmt.Sprintf("%s\t}\n", indent)
+	out += fmt.Sprintf("%s}\n", indent)
+
+	// for _, f0b := range b.ko.Spec.Rules {
+	//   f0found := false
+	//   for _, f0a := range a.ko.Spec.Rules {
+	//     if equalKeys(f0a, f0b, "Protocol", "Port") {
+	//       f0found = true
+	//       break
+	//     }
+	//   }
+	//   if !f0found {
+	//     delta.Add(fmt.Sprintf("Spec.Rules.%s", elementKey(f0b, "Protocol", "Port")), nil, f0b)
+	//   }
+	// }
+	out += fmt.Sprintf(
+		"%sfor _, %s := range %s {\n",
+		indent, secondElemVarName, secondResVarName,
+	)
+	out += fmt.Sprintf("%s\t%s := false\n", indent, foundVarName)
+	out += fmt.Sprintf(
+		"%s\tfor _, %s := range %s {\n",
+		indent, firstElemVarName, firstResVarName,
+	)
+	out += fmt.Sprintf("%s\t\tif %s {\n", indent, equalKeysCall)
+	out += fmt.Sprintf("%s\t\t\t%s = true\n", indent, foundVarName)
+	out += fmt.Sprintf("%s\t\t\tbreak\n", indent)
+	out += fmt.Sprintf("%s\t\t}\n", indent)
+	out += fmt.Sprintf("%s\t}\n", indent)
+	out += fmt.Sprintf("%s\tif !%s {\n", indent, foundVarName)
+	out += fmt.Sprintf(
+		"%s\t\t%s.Add(%s, nil, %s)\n",
+		indent, deltaVarName, secondElemPath.goExpr(), secondElemVarName,
+	)
+	out += fmt.Sprintf("%s\t}\n", indent)
+	out += fmt.Sprintf("%s}\n", indent)
+	return out
+}
+
+// compareMapOfStruct outputs Go code that compares two maps of struct values
+// from two resource fields key by key and adds the differences between the
+// values to a variable representing an `ackcompare.Delta`. Keys that are
+// present in only one of the maps are added to the delta with a nil value
+// for the other map.
+//
+// Output code will look something like this:
+//
+//   for f0key, f0a := range a.ko.Spec.Routes {
+//     f0b, f0ok := b.ko.Spec.Routes[f0key]
+//     if !f0ok {
+//       delta.Add(fmt.Sprintf("Spec.Routes.%v", f0key), f0a, nil)
+//     } else if ackcompare.HasNilDifference(f0a, f0b) {
+//       delta.Add(fmt.Sprintf("Spec.Routes.%v", f0key), f0a, f0b)
+//     } else if f0a != nil && f0b != nil {
+//       ...
+//     }
+//   }
+//   for f0key, f0b := range b.ko.Spec.Routes {
+//     if _, f0ok := a.ko.Spec.Routes[f0key]; !f0ok {
+//       delta.Add(fmt.Sprintf("Spec.Routes.%v", f0key), nil, f0b)
+//     }
+//   }
+func compareMapOfStruct(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// struct describing the SDK type of the field being compared
+	shape *awssdkmodel.Shape,
+	// String representing the name of the variable that is of type
+	// `*ackcompare.Delta`. We will generate Go code that calls the `Add()`
+	// method of this variable when differences between fields are detected.
+	deltaVarName string,
+	// String representing the name of the variable that represents the first
+	// CR under comparison. This will typically be something like
+	// "a.ko.Spec.Routes". See `templates/pkg/resource/delta.go.tpl`.
+	firstResVarName string,
+	// String representing the name of the variable that represents the second
+	// CR under comparison. This will typically be something like
+	// "b.ko.Spec.Routes". See `templates/pkg/resource/delta.go.tpl`.
+	secondResVarName string,
+	// The current field path being evaluated, e.g. "Spec.Routes"
+	fieldPath comparePath,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+	prefix := fmt.Sprintf("f%d", len(fieldPath.args))
+	keyVarName := prefix + "key"
+	okVarName := prefix + "ok"
+	firstElemVarName := prefix + "a"
+	secondElemVarName := prefix + "b"
+	elemPath := fieldPath.element("%v", keyVarName)
+
+	// for f0key, f0a := range a.ko.Spec.Routes {
+	out += fmt.Sprintf(
+		"%sfor %s, %s := range %s {\n",
+		indent, keyVarName, firstElemVarName, firstResVarName,
+	)
+	//   f0b, f0ok := b.ko.Spec.Routes[f0key]
+	out += fmt.Sprintf(
+		"%s\t%s, %s := %s[%s]\n",
+		indent, secondElemVarName, okVarName, secondResVarName, keyVarName,
+	)
+	//   if !f0ok {
+	//     delta.Add(fmt.Sprintf("Spec.Routes.%v", f0key), f0a, nil)
+	//   } else
+	out += fmt.Sprintf("%s\tif !%s {\n", indent, okVarName)
+	out += fmt.Sprintf(
+		"%s\t\t%s.Add(%s, %s, nil)\n",
+		indent, deltaVarName, elemPath.goExpr(), firstElemVarName,
+	)
+	out += fmt.Sprintf("%s\t} else {\n", indent)
+	out += compareStructElement(
+		cfg, r,
+		shape.ValueRef.Shape,
+		deltaVarName,
+		firstElemVarName,
+		secondElemVarName,
+		elemPath,
+		indentLevel+2,
+	)
+	out += fmt.Sprintf("%s\t}\n", indent)
+	out += fmt.Sprintf("%s}\n", indent)
+	// for f0key, f0b := range b.ko.Spec.Routes {
+	out += fmt.Sprintf(
+		"%sfor %s, %s := range %s {\n",
+		indent, keyVarName, secondElemVarName, secondResVarName,
+	)
+	//   if _, f0ok := a.ko.Spec.Routes[f0key]; !f0ok {
+	out += fmt.Sprintf(
+		"%s\tif _, %s := %s[%s]; !%s {\n",
+		indent, okVarName, firstResVarName, keyVarName, okVarName,
+	)
+	//     delta.Add(fmt.Sprintf("Spec.Routes.%v", f0key), nil, f0b)
+	out += fmt.Sprintf(
+		"%s\t\t%s.Add(%s, nil, %s)\n",
+		indent, deltaVarName, elemPath.goExpr(), secondElemVarName,
+	)
+	//   }
+	// }
+	out += fmt.Sprintf("%s\t}\n", indent)
+	out += fmt.Sprintf("%s}\n", indent)
+	return out
+}
+
+// compareStructElement outputs Go code that compares two struct pointers
+// taken from a list element or map value, adding a nil difference to the
+// delta and otherwise recursing into the members of the structs with
+// compareStruct
+func compareStructElement(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// struct describing the SDK type of the elements being compared
+	shape *awssdkmodel.Shape,
+	// String representing the name of the variable that is of type
+	// `*ackcompare.Delta`.
+	deltaVarName string,
+	// String representing the name of the variable holding the first
+	// element, e.g. "f0a"
+	firstElemVarName string,
+	// String representing the name of the variable holding the second
+	// element, e.g. "f0b"
+	secondElemVarName string,
+	// The field path of the element, e.g. "Spec.Rules.%d"
+	elemPath comparePath,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+	// if ackcompare.HasNilDifference(f0a, f0b) {
+	//   delta.Add(fmt.Sprintf("Spec.Rules.%d", f0idx), f0a, f0b)
+	// } else if f0a != nil && f0b != nil {
+	out += compareNil(
+		nil,
+		shape,
+		deltaVarName,
+		firstElemVarName,
+		secondElemVarName,
+		elemPath,
+		indentLevel,
+	)
+	out += fmt.Sprintf(
+		" else if %s != nil && %s != nil {\n",
+		firstElemVarName, secondElemVarName,
+	)
+	out += compareStruct(
+		cfg, r,
+		nil,
+		shape,
+		deltaVarName,
+		firstElemVarName,
+		secondElemVarName,
+		elemPath,
+		indentLevel+1,
+	)
+	// }
+	out += fmt.Sprintf("%s}\n", indent)
+	return out
+}
+
+// comparePath is the path to a field within the resources being compared
+type comparePath struct {
+	// delta is the path recorded in the `ackcompare.Delta`, e.g.
+	// "Spec.Author.Name". The paths of list elements and map values, and of
+	// the fields within them, contain a format verb for the index or key of
+	// the element as a part of the dotted path, e.g. "Spec.Rules.%d.Port" or
+	// "Spec.Routes.%v.Target", so that `Delta.DifferentAt("Spec.Rules")` is
+	// true for the differences within the elements
+	delta string
+	// args are the names of the Go variables holding the indexes and keys
+	// that fill in the format verbs of delta, outermost first
+	args []string
+	// config is the path of the field in the resource's field configs, e.g.
+	// "Author.Name" or "Rules..Port"
+	config string
+}
+
+// member returns the path of the supplied member of the struct at the path
+func (p comparePath) member(memberName string) comparePath {
+	return comparePath{
+		delta:  p.delta + "." + memberName,
+		args:   p.args,
+		config: p.config + "." + memberName,
+	}
+}
+
+// element returns the path of the list element or map value at the path
+// whose index or key is held in the supplied Go variable and is formatted
+// with the supplied format verb
+func (p comparePath) element(verb string, varName string) comparePath {
+	args := make([]string, 0, len(p.args)+1)
+	args = append(args, p.args...)
+	return comparePath{
+		delta:  p.delta + "." + verb,
+		args:   append(args, varName),
+		config: p.config + ".",
+	}
+}
+
+// goExpr returns the Go expression evaluating to the path recorded in the
+// `ackcompare.Delta`, e.g. `"Spec.Name"` or
+// `fmt.Sprintf("Spec.Rules.%d.Port", f0idx)`
+func (p comparePath) goExpr() string {
+	if len(p.args) == 0 {
+		return strconv.Quote(p.delta)
+	}
+	return fmt.Sprintf(
+		"fmt.Sprintf(%s, %s)",
+		strconv.Quote(p.delta), strings.Join(p.args, ", "),
+	)
+}
@@ -148,3 +148,358 @@ func TestCompareResource_Lambda_CodeSigningConfig(t *testing.T) {
 		),
 	)
 }
+
+func TestCompareResource_Lambda_Alias(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewGeneratorForService(t, "lambda")
+
+	crd := testutil.GetCRDByName(t, g, "Alias")
+	require.NotNil(crd)
+
+	// RoutingConfig.AdditionalVersionWeights is a map of floats
+	expected := `
+	if ackcompare.HasNilDifference(a.ko.Spec.Description, b.ko.Spec.Description) {
+		delta.Add("Spec.Description", a.ko.Spec.Description, b.ko.Spec.Description)
+	} else if a.ko.Spec.Description != nil && b.ko.Spec.Description != nil {
+		if *a.ko.Spec.Description != *b.ko.Spec.Description {
+			delta.Add("Spec.Description", a.ko.Spec.Description, b.ko.Spec.Description)
+		}
+	}
+	if ackcompare.HasNilDifference(a.ko.Spec.FunctionName, b.ko.Spec.FunctionName) {
+		delta.Add("Spec.FunctionName", a.ko.Spec.FunctionName, b.ko.Spec.FunctionName)
+	} else if a.ko.Spec.FunctionName != nil && b.ko.Spec.FunctionName != nil {
+		if *a.ko.Spec.FunctionName != *b.ko.Spec.FunctionName {
+			delta.Add("Spec.FunctionName", a.ko.Spec.FunctionName, b.ko.Spec.FunctionName)
+		}
+	}
+	if ackcompare.HasNilDifference(a.ko.Spec.FunctionVersion, b.ko.Spec.FunctionVersion) {
+		delta.Add("Spec.FunctionVersion", a.ko.Spec.FunctionVersion, b.ko.Spec.FunctionVersion)
+	} else if a.ko.Spec.FunctionVersion != nil && b.ko.Spec.FunctionVersion != nil {
+		if *a.ko.Spec.FunctionVersion != *b.ko.Spec.FunctionVersion {
+			delta.Add("Spec.FunctionVersion", a.ko.Spec.FunctionVersion, b.ko.Spec.FunctionVersion)
+		}
+	}
+	if ackcompare.HasNilDifference(a.ko.Spec.Name, b.ko.Spec.Name) {
+		delta.Add("Spec.Name", a.ko.Spec.Name, b.ko.Spec.Name)
+	} else if a.ko.Spec.Name != nil && b.ko.Spec.Name != nil {
+		if *a.ko.Spec.Name != *b.ko.Spec.Name {
+			delta.Add("Spec.Name", a.ko.Spec.Name, b.ko.Spec.Name)
+		}
+	}
+	if ackcompare.HasNilDifference(a.ko.Spec.RoutingConfig, b.ko.Spec.RoutingConfig) {
+		delta.Add("Spec.RoutingConfig", a.ko.Spec.RoutingConfig, b.ko.Spec.RoutingConfig)
+	} else if a.ko.Spec.RoutingConfig != nil && b.ko.Spec.RoutingConfig != nil {
+		if ackcompare.HasNilDifference(a.ko.Spec.RoutingConfig.AdditionalVersionWeights, b.ko.Spec.RoutingConfig.AdditionalVersionWeights) {
+			delta.Add("Spec.RoutingConfig.AdditionalVersionWeights", a.ko.Spec.RoutingConfig.AdditionalVersionWeights, b.ko.Spec.RoutingConfig.AdditionalVersionWeights)
+		} else if a.ko.Spec.RoutingConfig.AdditionalVersionWeights != nil && b.ko.Spec.RoutingConfig.AdditionalVersionWeights != nil {
+			if !reflect.DeepEqual(a.ko.Spec.RoutingConfig.AdditionalVersionWeights, b.ko.Spec.RoutingConfig.AdditionalVersionWeights) {
+				delta.Add("Spec.RoutingConfig.AdditionalVersionWeights", a.ko.Spec.RoutingConfig.AdditionalVersionWeights, b.ko.Spec.RoutingConfig.AdditionalVersionWeights)
+			}
+		}
+	}
+`
+	assert.Equal(
+		expected,
+		code.CompareResource(
+			crd.Config(), crd, "delta", "a.ko", "b.ko", 1,
+		),
+	)
+}
+
+func TestCompareResource_SQS_Queue(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewGeneratorForService(t, "sqs")
+
+	crd := testutil.GetCRDByName(t, g, "Queue")
+	require.NotNil(crd)
+
+	// The fields unpacked from the Attributes map are strings, except for the
+	// Policy field, which is configured with `type: json` and is compared
+	// semantically
+	expected := `
+	if ackcompare.HasNilDifference(a.ko.Spec.ContentBasedDeduplication, b.ko.Spec.ContentBasedDeduplication) {
+		delta.Add("Spec.ContentBasedDeduplication", a.ko.Spec.ContentBasedDeduplication, b.ko.Spec.ContentBasedDeduplication)
+	} else if a.ko.Spec.ContentBasedDeduplication != nil && b.ko.Spec.ContentBasedDeduplication != nil {
+		if *a.ko.Spec.ContentBasedDeduplication != *b.ko.Spec.ContentBasedDeduplication {
+			delta.Add("Spec.ContentBasedDeduplication", a.ko.Spec.ContentBasedDeduplication, b.ko.Spec.ContentBasedDeduplication)
+		}
+	}
+	if ackcompare.HasNilDifference(a.ko.Spec.DelaySeconds, b.ko.Spec.DelaySeconds) {
+		delta.Add("Spec.DelaySeconds", a.ko.Spec.DelaySeconds, b.ko.Spec.DelaySeconds)
+	} else if a.ko.Spec.DelaySeconds != nil && b.ko.Spec.DelaySeconds != nil {
+		if *a.ko.Spec.DelaySeconds != *b.ko.Spec.DelaySeconds {
+			delta.Add("Spec.DelaySeconds", a.ko.Spec.DelaySeconds, b.ko.Spec.DelaySeconds)
+		}
+	}
+	if ackcompare.HasNilDifference(a.ko.Spec.FifoQueue, b.ko.Spec.FifoQueue) {
+		delta.Add("Spec.FifoQueue", a.ko.Spec.FifoQueue, b.ko.Spec.FifoQueue)
+	} else if a.ko.Spec.FifoQueue != nil && b.ko.Spec.FifoQueue != nil {
+		if *a.ko.Spec.FifoQueue != *b.ko.Spec.FifoQueue {
+			delta.Add("Spec.FifoQueue", a.ko.Spec.FifoQueue, b.ko.Spec.FifoQueue)
+		}
+	}
+	if ackcompare.HasNilDifference(a.ko.Spec.KMSDataKeyReusePeriodSeconds, b.ko.Spec.KMSDataKeyReusePeriodSeconds) {
+		delta.Add("Spec.KMSDataKeyReusePeriodSeconds", a.ko.Spec.KMSDataKeyReusePeriodSeconds, b.ko.Spec.KMSDataKeyReusePeriodSeconds)
+	} else if a.ko.Spec.KMSDataKeyReusePeriodSeconds != nil && b.ko.Spec.KMSDataKeyReusePeriodSeconds != nil {
+		if *a.ko.Spec.KMSDataKeyReusePeriodSeconds != *b.ko.Spec.KMSDataKeyReusePeriodSeconds {
+			delta.Add("Spec.KMSDataKeyReusePeriodSeconds", a.ko.Spec.KMSDataKeyReusePeriodSeconds, b.ko.Spec.KMSDataKeyReusePeriodSeconds)
+		}
+	}
+	if ackcompare.HasNilDifference(a.ko.Spec.KMSMasterKeyID, b.ko.Spec.KMSMasterKeyID) {
+		delta.Add("Spec.KMSMasterKeyID", a.ko.Spec.KMSMasterKeyID, b.ko.Spec.KMSMasterKeyID)
+	} else if a.ko.Spec.KMSMasterKeyID != nil && b.ko.Spec.KMSMasterKeyID != nil {
+		if *a.ko.Spec.KMSMasterKeyID != *b.ko.Spec.KMSMasterKeyID {
+			delta.Add("Spec.KMSMasterKeyID", a.ko.Spec.KMSMasterKeyID, b.ko.Spec.KMSMasterKeyID)
+		}
+	}
+	if ackcompare.HasNilDifference(a.ko.Spec.MaximumMessageSize, b.ko.Spec.MaximumMessageSize) {
+		delta.Add("Spec.MaximumMessageSize", a.ko.Spec.MaximumMessageSize, b.ko.Spec.MaximumMessageSize)
+	} else if a.ko.Spec.MaximumMessageSize != nil && b.ko.Spec.MaximumMessageSize != nil {
+		if *a.ko.Spec.MaximumMessageSize != *b.ko.Spec.MaximumMessageSize {
+			delta.Add("Spec.MaximumMessageSize", a.ko.Spec.MaximumMessageSize, b.ko.Spec.MaximumMessageSize)
+		}
+	}
+	if ackcompare.HasNilDifference(a.ko.Spec.MessageRetentionPeriod, b.ko.Spec.MessageRetentionPeriod) {
+		delta.Add("Spec.MessageRetentionPeriod", a.ko.Spec.MessageRetentionPeriod, b.ko.Spec.MessageRetentionPeriod)
+	} else if a.ko.Spec.MessageRetentionPeriod != nil && b.ko.Spec.MessageRetentionPeriod != nil {
+		if *a.ko.Spec.MessageRetentionPeriod != *b.ko.Spec.MessageRetentionPeriod {
+			delta.Add("Spec.MessageRetentionPeriod", a.ko.Spec.MessageRetentionPeriod, b.ko.Spec.MessageRetentionPeriod)
+		}
+	}
+	if ackcompare.HasNilDifference(a.ko.Spec.Policy, b.ko.Spec.Policy) {
+		delta.Add("Spec.Policy", a.ko.Spec.Policy, b.ko.Spec.Policy)
+	} else if a.ko.Spec.Policy != nil && b.ko.Spec.Policy != nil {
+		if !equalJSON(a.ko.Spec.Policy.Raw, b.ko.Spec.Policy.Raw) {
+			delta.Add("Spec.Policy", a.ko.Spec.Policy, b.ko.Spec.Policy)
+		}
+	}
+	if ackcompare.HasNilDifference(a.ko.Spec.QueueName, b.ko.Spec.QueueName) {
+		delta.Add("Spec.QueueName", a.ko.Spec.QueueName, b.ko.Spec.QueueName)
+	} else if a.ko.Spec.QueueName != nil && b.ko.Spec.QueueName != nil {
+		if *a.ko.Spec.QueueName != *b.ko.Spec.QueueName {
+			delta.Add("Spec.QueueName", a.ko.Spec.QueueName, b.ko.Spec.QueueName)
+		}
+	}
+	if ackcompare.HasNilDifference(a.ko.Spec.ReceiveMessageWaitTimeSeconds, b.ko.Spec.ReceiveMessageWaitTimeSeconds) {
+		delta.Add("Spec.ReceiveMessageWaitTimeSeconds", a.ko.Spec.ReceiveMessageWaitTimeSeconds, b.ko.Spec.ReceiveMessageWaitTimeSeconds)
+	} else if a.ko.Spec.ReceiveMessageWaitTimeSeconds != nil && b.ko.Spec.ReceiveMessageWaitTimeSeconds != nil {
+		if *a.ko.Spec.ReceiveMessageWaitTimeSeconds != *b.ko.Spec.ReceiveMessageWaitTimeSeconds {
+			delta.Add("Spec.ReceiveMessageWaitTimeSeconds", a.ko.Spec.ReceiveMessageWaitTimeSeconds, b.ko.Spec.ReceiveMessageWaitTimeSeconds)
+		}
+	}
+	if ackcompare.HasNilDifference(a.ko.Spec.RedrivePolicy, b.ko.Spec.RedrivePolicy) {
+		delta.Add("Spec.RedrivePolicy", a.ko.Spec.RedrivePolicy, b.ko.Spec.RedrivePolicy)
+	} else if a.ko.Spec.RedrivePolicy != nil && b.ko.Spec.RedrivePolicy != nil {
+		if *a.ko.Spec.RedrivePolicy != *b.ko.Spec.RedrivePolicy {
+			delta.Add("Spec.RedrivePolicy", a.ko.Spec.RedrivePolicy, b.ko.Spec.RedrivePolicy)
+		}
+	}
+	if ackcompare.HasNilDifference(a.ko.Spec.Tags, b.ko.Spec.Tags) {
+		delta.Add("Spec.Tags", a.ko.Spec.Tags, b.ko.Spec.Tags)
+	} else if a.ko.Spec.Tags != nil && b.ko.Spec.Tags != nil {
+		if !ackcompare.MapStringStringPEqual(a.ko.Spec.Tags, b.ko.Spec.Tags) {
+			delta.Add("Spec.Tags", a.ko.Spec.Tags, b.ko.Spec.Tags)
+		}
+	}
+	if ackcompare.HasNilDifference(a.ko.Spec.VisibilityTimeout, b.ko.Spec.VisibilityTimeout) {
+		delta.Add("Spec.VisibilityTimeout", a.ko.Spec.VisibilityTimeout, b.ko.Spec.VisibilityTimeout)
+	} else if a.ko.Spec.VisibilityTimeout != nil && b.ko.Spec.VisibilityTimeout != nil {
+		if *a.ko.Spec.VisibilityTimeout != *b.ko.Spec.VisibilityTimeout {
+			delta.Add("Spec.VisibilityTimeout", a.ko.Spec.VisibilityTimeout, b.ko.Spec.VisibilityTimeout)
+		}
+	}
+`
+	assert.Equal(
+		expected,
+		code.CompareResource(
+			crd.Config(), crd, "delta", "a.ko", "b.ko", 1,
+		),
+	)
+}
+
+func TestCompareResource_CloudFront_CachePolicy(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewGeneratorForService(t, "cloudfront")
+
+	crd := testutil.GetCRDByName(t, g, "CachePolicy")
+	require.NotNil(crd)
+
+	// The generator.yaml ignores CachePolicyConfig.Comment, which is the
+	// promoted Spec.Comment field
+	got := code.CompareResource(crd.Config(), crd, "delta", "a.ko", "b.ko", 1)
+	assert.NotContains(got, "Spec.Comment")
+	assert.Contains(got, `delta.Add("Spec.MinTTL", a.ko.Spec.MinTTL, b.ko.Spec.MinTTL)`)
+}
+
+func TestCompareResource_APIGatewayV2_Route(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewGeneratorForService(t, "apigatewayv2")
+
+	crd := testutil.GetCRDByName(t, g, "Route")
+	require.NotNil(crd)
+
+	// RequestParameters is a map of ParameterConstraints structs. Each struct
+	// value is compared individually and keys missing from either map are
+	// recorded at the path of the key.
+	expected := `
+	if ackcompare.HasNilDifference(a.ko.Spec.RequestParameters, b.ko.Spec.RequestParameters) {
+		delta.Add("Spec.RequestParameters", a.ko.Spec.RequestParameters, b.ko.Spec.RequestParameters)
+	} else if a.ko.Spec.RequestParameters != nil && b.ko.Spec.RequestParameters != nil {
+		for f0key, f0a := range a.ko.Spec.RequestParameters {
+			f0b, f0ok := b.ko.Spec.RequestParameters[f0key]
+			if !f0ok {
+				delta.Add(fmt.Sprintf("Spec.RequestParameters.%v", f0key), f0a, nil)
+			} else {
+				if ackcompare.HasNilDifference(f0a, f0b) {
+					delta.Add(fmt.Sprintf("Spec.RequestParameters.%v", f0key), f0a, f0b)
+				} else if f0a != nil && f0b != nil {
+					if ackcompare.HasNilDifference(f0a.Required, f0b.Required) {
+						delta.Add(fmt.Sprintf("Spec.RequestParameters.%v.Required", f0key), f0a.Required, f0b.Required)
+					} else if f0a.Required != nil && f0b.Required != nil {
+						if *f0a.Required != *f0b.Required {
+							delta.Add(fmt.Sprintf("Spec.RequestParameters.%v.Required", f0key), f0a.Required, f0b.Required)
+						}
+					}
+				}
+			}
+		}
+		for f0key, f0b := range b.ko.Spec.RequestParameters {
+			if _, f0ok := a.ko.Spec.RequestParameters[f0key]; !f0ok {
+				delta.Add(fmt.Sprintf("Spec.RequestParameters.%v", f0key), nil, f0b)
+			}
+		}
+	}
+`
+	got := code.CompareResource(crd.Config(), crd, "delta", "a.ko", "b.ko", 1)
+	assert.Contains(got, expected)
+}
+
+func TestCompareResource_ElastiCache_ReplicationGroup(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewGeneratorForService(t, "elasticache")
+
+	crd := testutil.GetCRDByName(t, g, "ReplicationGroup")
+	require.NotNil(crd)
+
+	got := code.CompareResource(crd.Config(), crd, "delta", "a.ko", "b.ko", 1)
+
+	// NodeGroupConfiguration is a list of NodeGroupConfiguration structs.
+	// Lists of different lengths are recorded as a whole, otherwise each
+	// element is compared individually.
+	expected := `
+	if len(a.ko.Spec.NodeGroupConfiguration) != len(b.ko.Spec.NodeGroupConfiguration) {
+		delta.Add("Spec.NodeGroupConfiguration", a.ko.Spec.NodeGroupConfiguration, b.ko.Spec.NodeGroupConfiguration)
+	} else {
+		for f0idx := range a.ko.Spec.NodeGroupConfiguration {
+			f0a := a.ko.Spec.NodeGroupConfiguration[f0idx]
+			f0b := b.ko.Spec.NodeGroupConfiguration[f0idx]
+			if ackcompare.HasNilDifference(f0a, f0b) {
+				delta.Add(fmt.Sprintf("Spec.NodeGroupConfiguration.%d", f0idx), f0a, f0b)
+			} else if f0a != nil && f0b != nil {
+				if ackcompare.HasNilDifference(f0a.NodeGroupID, f0b.NodeGroupID) {
+					delta.Add(fmt.Sprintf("Spec.NodeGroupConfiguration.%d.NodeGroupID", f0idx), f0a.NodeGroupID, f0b.NodeGroupID)
+				} else if f0a.NodeGroupID != nil && f0b.NodeGroupID != nil {
+					if *f0a.NodeGroupID != *f0b.NodeGroupID {
+						delta.Add(fmt.Sprintf("Spec.NodeGroupConfiguration.%d.NodeGroupID", f0idx), f0a.NodeGroupID, f0b.NodeGroupID)
+					}
+				}
+`
+	assert.Contains(got, expected)
+
+	// The generator.yaml ignores NodeGroupConfiguration..Slots, which is a
+	// member of the list elements
+	assert.NotContains(got, "Slots")
+
+	// The resource's compare.ignore paths ignore a top-level field, a member
+	// of list elements and a struct nested in list elements
+	assert.NotContains(got, "SnapshotWindow")
+	assert.NotContains(got, "ReplicaCount")
+	assert.NotContains(got, "CloudWatchLogsDetails")
+	assert.Contains(got, `delta.Add(fmt.Sprintf("Spec.LogDeliveryConfigurations.%d.DestinationDetails.KinesisFirehoseDetails", f0idx), f0a.DestinationDetails.KinesisFirehoseDetails, f0b.DestinationDetails.KinesisFirehoseDetails)`)
+
+	// Engine and KmsKeyId are compared with built-in comparators and
+	// EngineVersion with a custom function of the controller
+	expected = `
+	if ackcompare.HasNilDifference(a.ko.Spec.Engine, b.ko.Spec.Engine) {
+		delta.Add("Spec.Engine", a.ko.Spec.Engine, b.ko.Spec.Engine)
+	} else if a.ko.Spec.Engine != nil && b.ko.Spec.Engine != nil {
+		if !equalCaseInsensitive(a.ko.Spec.Engine, b.ko.Spec.Engine) {
+			delta.Add("Spec.Engine", a.ko.Spec.Engine, b.ko.Spec.Engine)
+		}
+	}
+	if ackcompare.HasNilDifference(a.ko.Spec.EngineVersion, b.ko.Spec.EngineVersion) {
+		delta.Add("Spec.EngineVersion", a.ko.Spec.EngineVersion, b.ko.Spec.EngineVersion)
+	} else if a.ko.Spec.EngineVersion != nil && b.ko.Spec.EngineVersion != nil {
+		if !equalEngineVersion(a.ko.Spec.EngineVersion, b.ko.Spec.EngineVersion) {
+			delta.Add("Spec.EngineVersion", a.ko.Spec.EngineVersion, b.ko.Spec.EngineVersion)
+		}
+	}
+`
+	assert.Contains(got, expected)
+	assert.Contains(got, "if !equalARNOrName(a.ko.Spec.KMSKeyID, b.ko.Spec.KMSKeyID) {")
+
+	// SecurityGroupIds is configured with `compare.unordered`
+	expected = `
+	if !equalUnordered(a.ko.Spec.SecurityGroupIDs, b.ko.Spec.SecurityGroupIDs) {
+		delta.Add("Spec.SecurityGroupIDs", a.ko.Spec.SecurityGroupIDs, b.ko.Spec.SecurityGroupIDs)
+	}
+`
+	assert.Contains(got, expected)
+
+	// Tags is configured with `compare.key_fields`, so the elements of the
+	// lists are matched, and identified in the delta, by their Key instead of
+	// their index
+	expected = `
+	for _, f0a := range a.ko.Spec.Tags {
+		f0key := elementKey(f0a, "Key")
+		f0found := false
+		for _, f0b := range b.ko.Spec.Tags {
+			if !equalKeys(f0a, f0b, "Key") {
+				continue
+			}
+			f0found = true
+			if ackcompare.HasNilDifference(f0a.Key, f0b.Key) {
+				delta.Add(fmt.Sprintf("Spec.Tags.%s.Key", f0key), f0a.Key, f0b.Key)
+			} else if f0a.Key != nil && f0b.Key != nil {
+				if *f0a.Key != *f0b.Key {
+					delta.Add(fmt.Sprintf("Spec.Tags.%s.Key", f0key), f0a.Key, f0b.Key)
+				}
+			}
+			if ackcompare.HasNilDifference(f0a.Value, f0b.Value) {
+				delta.Add(fmt.Sprintf("Spec.Tags.%s.Value", f0key), f0a.Value, f0b.Value)
+			} else if f0a.Value != nil && f0b.Value != nil {
+				if *f0a.Value != *f0b.Value {
+					delta.Add(fmt.Sprintf("Spec.Tags.%s.Value", f0key), f0a.Value, f0b.Value)
+				}
+			}
+			break
+		}
+		if !f0found {
+			delta.Add(fmt.Sprintf("Spec.Tags.%s", f0key), f0a, nil)
+		}
+	}
+	for _, f0b := range b.ko.Spec.Tags {
+		f0found := false
+		for _, f0a := range a.ko.Spec.Tags {
+			if equalKeys(f0a, f0b, "Key") {
+				f0found = true
+				break
+			}
+		}
+		if !f0found {
+			delta.Add(fmt.Sprintf("Spec.Tags.%s", elementKey(f0b, "Key")), nil, f0b)
+		}
+	}
+`
+	assert.Contains(got, expected)
+}
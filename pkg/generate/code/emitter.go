@@ -0,0 +1,261 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code
+
+import (
+	"fmt"
+	"reflect"
+
+	awssdkmodel "github.com/aws/aws-sdk-go/private/model/api"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
+)
+
+const (
+	// DefaultErrorReturn is the statement that Go code output by an Emitter
+	// uses to return an error when Options.ErrorReturn is empty
+	DefaultErrorReturn = "return nil, err"
+	// DefaultSecretResolver is the function that Go code output by an Emitter
+	// calls to resolve the value of a SecretKeyReference when
+	// Options.SecretResolver is empty
+	DefaultSecretResolver = "rm.rr.SecretValueFromReference"
+	// DefaultAccountID is the expression that Go code output by an Emitter
+	// evaluates to get the AWS account ID of a singleton resource when
+	// Options.AccountID is empty
+	DefaultAccountID = "string(rm.awsAccountID)"
+)
+
+// resourceOpNames are the names of the operations in `model.Ops`, which an
+// Emitter outputs no code for when the resource has no such operation
+var resourceOpNames = map[string]bool{
+	"Create":        true,
+	"ReadOne":       true,
+	"ReadMany":      true,
+	"Update":        true,
+	"Delete":        true,
+	"GetAttributes": true,
+	"SetAttributes": true,
+}
+
+// Options configures the Go code output by an Emitter
+type Options struct {
+	// Op is the name of the API operation whose Input or Output shape the
+	// code is output for. It is either the name of one of the resource's
+	// operations in `model.Ops`, e.g. "ReadOne", or the name of any operation
+	// of the API, e.g. "ListTagsForResource".
+	Op string
+	// SourceVarName is the name of the variable that values are read from,
+	// e.g. "r.ko" when setting an Input shape or "resp" when setting a CR.
+	// When comparing resources, it is the name of the first CR, e.g. "a.ko".
+	SourceVarName string
+	// TargetVarName is the name of the variable that values are set on, e.g.
+	// "res" when setting an Input shape or "ko" when setting a CR. When
+	// comparing resources, it is the name of the second CR, e.g. "b.ko".
+	TargetVarName string
+	// DeltaVarName is the name of the `*ackcompare.Delta` variable that
+	// differences between compared resources are added to, e.g. "delta"
+	DeltaVarName string
+	// IndentLevel is the number of levels of indentation to use
+	IndentLevel int
+	// PerformSpecUpdate instructs the code setting a CR from an Output shape
+	// to set the CR's Spec fields as well as its Status fields
+	PerformSpecUpdate bool
+	// ErrorReturn is the statement that returns from the enclosing function
+	// when the output code encounters an error. Defaults to
+	// DefaultErrorReturn.
+	ErrorReturn string
+	// SecretResolver is the function that the output code calls, with a
+	// context and a SecretKeyReference, to resolve a Secret's value. Defaults
+	// to DefaultSecretResolver.
+	SecretResolver string
+	// AccountID is the expression, evaluating to a string, that the output
+	// code sets the AccountId member of a singleton resource's Input shape
+	// to, e.g. "accountID". Defaults to DefaultAccountID.
+	AccountID string
+}
+
+// NewOptions returns Options built from the supplied pairs of Options field
+// names and values, e.g. `NewOptions("Op", "ReadOne", "IndentLevel", 1)`. It
+// lets templates construct Options.
+func NewOptions(pairs ...interface{}) (Options, error) {
+	opts := Options{}
+	if len(pairs)%2 != 0 {
+		return opts, fmt.Errorf(
+			"expected pairs of option names and values but got %d arguments",
+			len(pairs),
+		)
+	}
+	optsValue := reflect.ValueOf(&opts).Elem()
+	for x := 0; x < len(pairs); x += 2 {
+		name, ok := pairs[x].(string)
+		if !ok {
+			return opts, fmt.Errorf(
+				"expected the name of an option but got %v", pairs[x],
+			)
+		}
+		field := optsValue.FieldByName(name)
+		if !field.IsValid() {
+			return opts, fmt.Errorf("unknown option %s", name)
+		}
+		value := reflect.ValueOf(pairs[x+1])
+		if !value.IsValid() || !value.Type().AssignableTo(field.Type()) {
+			return opts, fmt.Errorf(
+				"expected a %s value for option %s but got %v",
+				field.Type(), name, pairs[x+1],
+			)
+		}
+		field.Set(value)
+	}
+	return opts, nil
+}
+
+// secretResolution describes how the Go code setting an Input shape resolves
+// the values of SecretKeyReference fields
+type secretResolution struct {
+	// The function called with a context and a SecretKeyReference
+	resolver string
+	// The statement returning the error returned by the resolver, or any
+	// other error encountered while setting the Input shape
+	errorReturn string
+}
+
+// defaultSecretResolution is the secretResolution of the Go code output by the
+// positional emitter functions, e.g. SetSDK
+var defaultSecretResolution = secretResolution{
+	resolver:    DefaultSecretResolver,
+	errorReturn: DefaultErrorReturn,
+}
+
+// secretResolution returns the secretResolution configured by the Options
+func (opts Options) secretResolution() secretResolution {
+	secrets := defaultSecretResolution
+	if opts.SecretResolver != "" {
+		secrets.resolver = opts.SecretResolver
+	}
+	secrets.errorReturn = opts.errorReturn()
+	return secrets
+}
+
+// accountID returns the AWS account ID expression configured by the Options
+func (opts Options) accountID() string {
+	if opts.AccountID != "" {
+		return opts.AccountID
+	}
+	return DefaultAccountID
+}
+
+// errorReturn returns the error return statement configured by the Options
+func (opts Options) errorReturn() string {
+	if opts.ErrorReturn != "" {
+		return opts.ErrorReturn
+	}
+	return DefaultErrorReturn
+}
+
+// Emitter outputs Go code for a resource, configured by Options naming the
+// target API operation
+type Emitter struct {
+	r *model.CRD
+}
+
+// NewEmitter returns an Emitter of Go code for the supplied resource
+func NewEmitter(r *model.CRD) *Emitter {
+	return &Emitter{r: r}
+}
+
+// operation returns the API operation named by the Options or nil if it is
+// one of the resource's operations that the resource doesn't have. It panics
+// if the API has no operation with that name.
+func (e *Emitter) operation(opts Options) *awssdkmodel.Operation {
+	op := e.r.Operation(opts.Op)
+	if op == nil && !resourceOpNames[opts.Op] {
+		msg := fmt.Sprintf(
+			"GENERATION FAILURE! unknown operation %s for resource %s",
+			opts.Op, e.r.Names.Original,
+		)
+		panic(msg)
+	}
+	return op
+}
+
+// SetSDK returns the Go code that sets the Input shape of the operation from
+// the CR. See SetSDK, SetSDKGetAttributes and SetSDKSetAttributes.
+func (e *Emitter) SetSDK(opts Options) string {
+	op := e.operation(opts)
+	if op == nil {
+		return ""
+	}
+	cfg := e.r.Config()
+	switch opts.Op {
+	case "GetAttributes":
+		return SetSDKGetAttributes(
+			cfg, e.r, opts.SourceVarName, opts.TargetVarName, opts.IndentLevel,
+		)
+	case "SetAttributes":
+		return SetSDKSetAttributes(
+			cfg, e.r, opts.SourceVarName, opts.TargetVarName, opts.IndentLevel,
+		)
+	}
+	return setSDKForOperation(
+		cfg, e.r, opts.secretResolution(), opts.accountID(), op,
+		opts.SourceVarName, opts.TargetVarName, opts.IndentLevel,
+	)
+}
+
+// SetResource returns the Go code that sets the CR from the Output shape of
+// the operation. See SetResource and SetResourceGetAttributes.
+func (e *Emitter) SetResource(opts Options) string {
+	op := e.operation(opts)
+	if op == nil {
+		return ""
+	}
+	cfg := e.r.Config()
+	switch opts.Op {
+	case "ReadMany":
+		return setResourceReadMany(
+			cfg, e.r, opts.errorReturn(), op,
+			opts.SourceVarName, opts.TargetVarName, opts.IndentLevel,
+		)
+	case "GetAttributes":
+		return SetResourceGetAttributes(
+			cfg, e.r, opts.SourceVarName, opts.TargetVarName, opts.IndentLevel,
+		)
+	}
+	return setResourceForOperation(
+		cfg, e.r, opts.errorReturn(), op, opts.SourceVarName,
+		opts.TargetVarName, opts.IndentLevel, opts.PerformSpecUpdate,
+	)
+}
+
+// CompareResource returns the Go code that adds the differences between the
+// CRs to the delta. The operation is not used. See CompareResource.
+func (e *Emitter) CompareResource(opts Options) string {
+	return CompareResource(
+		e.r.Config(), e.r, opts.DeltaVarName,
+		opts.SourceVarName, opts.TargetVarName, opts.IndentLevel,
+	)
+}
+
+// RequiredFieldsMissing returns the Go code of a condition checking that the
+// CR's fields for the required members of the Input shape of the operation
+// are set. See CheckRequiredFieldsMissingFromShape.
+func (e *Emitter) RequiredFieldsMissing(opts Options) string {
+	op := e.operation(opts)
+	if op == nil {
+		return ""
+	}
+	return checkRequiredFieldsMissingFromInput(
+		e.r, op, opts.SourceVarName, opts.IndentLevel,
+	)
+}
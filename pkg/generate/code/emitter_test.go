@@ -0,0 +1,247 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code_test
+
+import (
+	"bytes"
+	"testing"
+	ttpl "text/template"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/generate/code"
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
+	"github.com/aws-controllers-k8s/code-generator/pkg/testutil"
+)
+
+func TestNewOptions(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	opts, err := code.NewOptions(
+		"Op", "ReadOne",
+		"SourceVarName", "r.ko",
+		"TargetVarName", "input",
+		"IndentLevel", 2,
+		"PerformSpecUpdate", true,
+	)
+	require.Nil(err)
+	assert.Equal(
+		code.Options{
+			Op:                "ReadOne",
+			SourceVarName:     "r.ko",
+			TargetVarName:     "input",
+			IndentLevel:       2,
+			PerformSpecUpdate: true,
+		},
+		opts,
+	)
+
+	_, err = code.NewOptions("Op")
+	assert.NotNil(err)
+	_, err = code.NewOptions("Operation", "ReadOne")
+	assert.NotNil(err)
+	_, err = code.NewOptions("IndentLevel", "1")
+	assert.NotNil(err)
+	_, err = code.NewOptions(1, "ReadOne")
+	assert.NotNil(err)
+}
+
+func TestEmitter_ElastiCache_ReplicationGroup_ResourceOps(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewGeneratorForService(t, "elasticache")
+
+	crd := testutil.GetCRDByName(t, g, "ReplicationGroup")
+	require.NotNil(crd)
+
+	e := code.NewEmitter(crd)
+	assert.Equal(
+		code.SetSDK(crd.Config(), crd, model.OpTypeCreate, "r.ko", "res", 1),
+		e.SetSDK(code.Options{
+			Op:            "Create",
+			SourceVarName: "r.ko",
+			TargetVarName: "res",
+			IndentLevel:   1,
+		}),
+	)
+	assert.Equal(
+		code.SetResource(crd.Config(), crd, model.OpTypeList, "resp", "ko", 1, true),
+		e.SetResource(code.Options{
+			Op:                "ReadMany",
+			SourceVarName:     "resp",
+			TargetVarName:     "ko",
+			IndentLevel:       1,
+			PerformSpecUpdate: true,
+		}),
+	)
+	assert.Equal(
+		code.CompareResource(crd.Config(), crd, "delta", "a.ko", "b.ko", 1),
+		e.CompareResource(code.Options{
+			DeltaVarName:  "delta",
+			SourceVarName: "a.ko",
+			TargetVarName: "b.ko",
+			IndentLevel:   1,
+		}),
+	)
+	// ReplicationGroup has no GetAttributes operation
+	assert.Equal("", e.SetSDK(code.Options{Op: "GetAttributes"}))
+}
+
+func TestEmitter_ElastiCache_ReplicationGroup_APIOp(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewGeneratorForService(t, "elasticache")
+
+	crd := testutil.GetCRDByName(t, g, "ReplicationGroup")
+	require.NotNil(crd)
+
+	expected := `
+	if r.ko.Spec.ReplicationGroupID != nil {
+		input.SetReplicationGroupId(*r.ko.Spec.ReplicationGroupID)
+	}
+`
+	e := code.NewEmitter(crd)
+	assert.Equal(
+		expected,
+		e.SetSDK(code.Options{
+			Op:            "ModifyReplicationGroupShardConfiguration",
+			SourceVarName: "r.ko",
+			TargetVarName: "input",
+			IndentLevel:   1,
+		}),
+	)
+	assert.Panics(func() {
+		e.SetSDK(code.Options{Op: "DescribeEverything"})
+	})
+}
+
+func TestEmitter_ElastiCache_ReplicationGroup_SecretResolution(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewGeneratorForService(t, "elasticache")
+
+	crd := testutil.GetCRDByName(t, g, "ReplicationGroup")
+	require.NotNil(crd)
+
+	expected := `
+	if r.ko.Spec.AuthToken != nil {
+		tmpSecret, err := resolveSecret(ctx, r.ko.Spec.AuthToken)
+		if err != nil {
+			return managed.ExternalCreation{}, err
+		}
+		if tmpSecret != "" {
+			input.SetAuthToken(tmpSecret)
+		}
+	}
+`
+	got := code.NewEmitter(crd).SetSDK(code.Options{
+		Op:             "Create",
+		SourceVarName:  "r.ko",
+		TargetVarName:  "input",
+		IndentLevel:    1,
+		ErrorReturn:    "return managed.ExternalCreation{}, err",
+		SecretResolver: "resolveSecret",
+	})
+	assert.Contains(got, expected[1:])
+	assert.NotContains(got, "rm.rr.SecretValueFromReference")
+}
+
+func TestEmitter_S3Control_PublicAccessBlock_AccountID(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewGeneratorForService(t, "s3control")
+
+	crd := testutil.GetCRDByName(t, g, "PublicAccessBlock")
+	require.NotNil(crd)
+
+	got := code.NewEmitter(crd).SetSDK(code.Options{
+		Op:            "Delete",
+		SourceVarName: "cr",
+		TargetVarName: "res",
+		IndentLevel:   1,
+		AccountID:     "accountID",
+	})
+	assert.Equal("\n\tres.SetAccountId(accountID)\n", got)
+	assert.NotContains(got, "rm.awsAccountID")
+}
+
+func TestFuncMap_ElastiCache_ReplicationGroup(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewGeneratorForService(t, "elasticache")
+
+	crd := testutil.GetCRDByName(t, g, "ReplicationGroup")
+	require.NotNil(crd)
+
+	tpl, err := ttpl.New("test").Funcs(code.FuncMap()).Parse(
+		`{{ GoCodeSetSDK . (EmitterOptions "Op" "ModifyReplicationGroupShardConfiguration" "SourceVarName" "r.ko" "TargetVarName" "input" "IndentLevel" 0) }}`,
+	)
+	require.Nil(err)
+	var b bytes.Buffer
+	require.Nil(tpl.Execute(&b, crd))
+
+	expected := `
+if r.ko.Spec.ReplicationGroupID != nil {
+	input.SetReplicationGroupId(*r.ko.Spec.ReplicationGroupID)
+}
+`
+	assert.Equal(expected, b.String())
+}
+
+func TestEmitter_SageMaker_FlowDefinition_JSONErrorReturn(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewGeneratorForService(t, "sagemaker")
+
+	crd := testutil.GetCRDByName(t, g, "FlowDefinition")
+	require.NotNil(crd)
+
+	// Invalid JSON documents fail with the configured error return instead
+	// of being dropped
+	errorReturn := "return managed.ExternalCreation{}, err"
+	got := code.NewEmitter(crd).SetSDK(code.Options{
+		Op:            "Create",
+		SourceVarName: "cr",
+		TargetVarName: "res",
+		IndentLevel:   1,
+		ErrorReturn:   errorReturn,
+	})
+	assert.Contains(
+		got,
+		"HumanLoopActivationConditions.Raw, &tmpJSON); err != nil {\n"+
+			"\t\t\t\t\t"+errorReturn+"\n\t\t\t\t}\n"+
+			"\t\t\t\tf1f0.SetHumanLoopActivationConditions(tmpJSON)\n",
+	)
+	got = code.NewEmitter(crd).SetResource(code.Options{
+		Op:                "ReadOne",
+		SourceVarName:     "resp",
+		TargetVarName:     "cr",
+		IndentLevel:       1,
+		PerformSpecUpdate: true,
+		ErrorReturn:       errorReturn,
+	})
+	assert.Contains(
+		got,
+		"HumanLoopActivationConditions); err != nil {\n"+
+			"\t\t\t\t\t"+errorReturn+"\n\t\t\t\t} else {\n",
+	)
+}
@@ -0,0 +1,285 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	awssdkmodel "github.com/aws/aws-sdk-go/private/model/api"
+
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/generate/config"
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
+)
+
+// defaultAlreadyExistsCode is the exception code returned by a fake service
+// API when a resource is created twice and the API model declares no
+// exception for that case
+const defaultAlreadyExistsCode = "ResourceAlreadyExistsException"
+
+// FakeKind returns the Go code for the description of the resource that the
+// generated in-memory fake of the service API uses to store, look up and
+// report on the resource's records.
+//
+// Output code will look something like this:
+//
+//   &kind{
+//       name: "Repository",
+//       keyMembers: []string{"RepositoryName"},
+//       inputWrapper: "",
+//       aliases: map[string]string{},
+//       readOnlyAttributes: []string{},
+//       readyMember: "",
+//       readyValue: "",
+//       notFoundCode: "RepositoryNotFoundException",
+//       notFoundMessage: "Repository not found",
+//       alreadyExistsCode: "RepositoryAlreadyExistsException",
+//   }
+func FakeKind(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	indent := strings.Repeat("\t", indentLevel)
+	readyMember, readyValue := fakeReadiness(r)
+	keyMembers := []string{}
+	for _, memberName := range fakeKeyMemberNames(r) {
+		keyMembers = append(keyMembers, fmt.Sprintf("%q", memberName))
+	}
+	out := "&kind{\n"
+	out += fmt.Sprintf("%s\tname: %q,\n", indent, r.Names.Original)
+	out += fmt.Sprintf(
+		"%s\tkeyMembers: []string{%s},\n", indent, strings.Join(keyMembers, ", "),
+	)
+	out += fmt.Sprintf("%s\tinputWrapper: %q,\n", indent, r.UnwrappedInputMember())
+	out += fmt.Sprintf("%s\taliases: map[string]string{", indent)
+	aliases := fakeAliases(r)
+	if len(aliases) > 0 {
+		out += "\n"
+		fieldNames := []string{}
+		for fieldName := range aliases {
+			fieldNames = append(fieldNames, fieldName)
+		}
+		sort.Strings(fieldNames)
+		for _, fieldName := range fieldNames {
+			out += fmt.Sprintf(
+				"%s\t\t%q: %q,\n", indent, fieldName, aliases[fieldName],
+			)
+		}
+		out += indent + "\t"
+	}
+	out += "},\n"
+	readOnlyAttrs := []string{}
+	for _, attrName := range fakeReadOnlyAttributeNames(r) {
+		readOnlyAttrs = append(readOnlyAttrs, fmt.Sprintf("%q", attrName))
+	}
+	out += fmt.Sprintf(
+		"%s\treadOnlyAttributes: []string{%s},\n",
+		indent, strings.Join(readOnlyAttrs, ", "),
+	)
+	out += fmt.Sprintf("%s\treadyMember: %q,\n", indent, readyMember)
+	out += fmt.Sprintf("%s\treadyValue: %q,\n", indent, readyValue)
+	out += fmt.Sprintf("%s\tnotFoundCode: %q,\n", indent, r.ExceptionCode(404))
+	out += fmt.Sprintf(
+		"%s\tnotFoundMessage: %q,\n", indent, fakeNotFoundMessage(cfg, r),
+	)
+	out += fmt.Sprintf(
+		"%s\talreadyExistsCode: %q,\n", indent, fakeAlreadyExistsCode(r),
+	)
+	out += indent + "}"
+	return out
+}
+
+// FakeOutputWrapperPath returns the Go code for the slice of names of the
+// members wrapping the resource's fields in the Output shape of the supplied
+// operation, or "nil" if the Output shape isn't wrapped.
+//
+// Output code will look something like this:
+//
+//   []string{"Repository"}
+func FakeOutputWrapperPath(
+	r *model.CRD,
+	// The name of the operation, e.g. "Create"
+	opName string,
+) string {
+	op := r.Operation(opName)
+	if op == nil || op.OutputRef.Shape == nil {
+		return "nil"
+	}
+	wrapperPath := []string{}
+	for _, wrapperName := range outputWrapperPath(r, op) {
+		wrapperPath = append(wrapperPath, fmt.Sprintf("%q", wrapperName))
+	}
+	if len(wrapperPath) == 0 {
+		return "nil"
+	}
+	return fmt.Sprintf("[]string{%s}", strings.Join(wrapperPath, ", "))
+}
+
+// fakeKeyMemberNames returns the names of the Input shape members that
+// identify a record of the resource. These are the required string members of
+// the Delete operation's Input shape or, failing that, of the ReadOne or
+// GetAttributes operation's Input shape. Resources with none of these
+// operations are identified by the member for their Spec identifier field.
+// Singleton resources are identified by nothing at all.
+func fakeKeyMemberNames(
+	r *model.CRD,
+) []string {
+	if r.IsSingleton() {
+		return []string{}
+	}
+	for _, op := range []*awssdkmodel.Operation{
+		r.Ops.Delete, r.Ops.ReadOne, r.Ops.GetAttributes,
+	} {
+		if op == nil || op.InputRef.Shape == nil {
+			continue
+		}
+		res := []string{}
+		inputShape := op.InputRef.Shape
+		for _, memberName := range inputShape.Required {
+			memberRef, found := inputShape.MemberRefs[memberName]
+			if found && memberRef.Shape.Type == "string" {
+				res = append(res, memberName)
+			}
+		}
+		if len(res) > 0 {
+			return res
+		}
+	}
+	if idField := r.SpecIdentifierField(); idField != nil {
+		for memberName, field := range r.SpecFields {
+			if field.Names.Camel == *idField {
+				return []string{memberName}
+			}
+		}
+	}
+	return []string{}
+}
+
+// fakeAliases returns the names of the Input shape members that the resource
+// renames, by the name of the resource's field
+func fakeAliases(
+	r *model.CRD,
+) map[string]string {
+	res := map[string]string{}
+	for _, op := range []*awssdkmodel.Operation{
+		r.Ops.Create, r.Ops.ReadOne, r.Ops.ReadMany, r.Ops.GetAttributes,
+		r.Ops.SetAttributes, r.Ops.Update, r.Ops.Delete,
+	} {
+		if op == nil || op.InputRef.Shape == nil {
+			continue
+		}
+		for _, memberName := range op.InputRef.Shape.MemberNames() {
+			if renamed, found := r.InputFieldRename(op.Name, memberName); found {
+				res[renamed] = memberName
+			}
+		}
+	}
+	return res
+}
+
+// fakeReadOnlyAttributeNames returns the sorted names of the read-only
+// attributes of a resource that unpacks its Attributes map. The service API,
+// and so the fake, sets these attributes.
+func fakeReadOnlyAttributeNames(
+	r *model.CRD,
+) []string {
+	res := []string{}
+	if !r.UnpacksAttributesMap() {
+		return res
+	}
+	for fieldName, fieldConfig := range r.Config().ResourceFields(r.Names.Original) {
+		if fieldConfig != nil && fieldConfig.IsAttribute && fieldConfig.IsReadOnly {
+			res = append(res, fieldName)
+		}
+	}
+	sort.Strings(res)
+	return res
+}
+
+// fakeReadiness returns the name of the member holding the resource's
+// lifecycle status and the status value meaning the resource is available.
+// Empty strings are returned if the resource has no readiness configuration or
+// if its lifecycle status isn't a top-level string field.
+func fakeReadiness(
+	r *model.CRD,
+) (string, string) {
+	readiness := r.Readiness()
+	if readiness == nil || len(readiness.Ready) == 0 {
+		return "", ""
+	}
+	fields := r.FieldsAlongPath(readiness.Path)
+	if len(fields) != 1 || fields[0].GoType != "*string" {
+		return "", ""
+	}
+	for memberName, field := range r.SpecFields {
+		if field == fields[0] {
+			return memberName, readiness.Ready[0]
+		}
+	}
+	for memberName, field := range r.StatusFields {
+		if field == fields[0] {
+			return memberName, readiness.Ready[0]
+		}
+	}
+	return "", ""
+}
+
+// fakeNotFoundMessage returns the message of the NotFound exception returned
+// by the fake service API. The message satisfies any message_prefix or
+// message_suffix configured for the resource's 404 exception, since the
+// generated controller checks it.
+func fakeNotFoundMessage(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+) string {
+	msg := r.Names.Original + " not found"
+	rConfig, ok := cfg.ResourceConfig(r.Names.Original)
+	if !ok || rConfig.Exceptions == nil {
+		return msg
+	}
+	excConfig, ok := rConfig.Exceptions.Errors[404]
+	if !ok {
+		return msg
+	}
+	if excConfig.MessagePrefix != nil {
+		msg = *excConfig.MessagePrefix + " " + msg
+	}
+	if excConfig.MessageSuffix != nil {
+		msg = msg + " " + *excConfig.MessageSuffix
+	}
+	return msg
+}
+
+// fakeAlreadyExistsCode returns the code of the exception the Create
+// operation returns for a resource that already exists
+func fakeAlreadyExistsCode(
+	r *model.CRD,
+) string {
+	if r.Ops.Create == nil {
+		return defaultAlreadyExistsCode
+	}
+	for _, errShapeRef := range r.Ops.Create.ErrorRefs {
+		code := errShapeRef.Shape.ErrorInfo.Code
+		if code == "" {
+			code = errShapeRef.Shape.ShapeName
+		}
+		if strings.Contains(code, "Exists") {
+			return code
+		}
+	}
+	return defaultAlreadyExistsCode
+}
@@ -0,0 +1,119 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/generate/code"
+	"github.com/aws-controllers-k8s/code-generator/pkg/testutil"
+)
+
+func TestFakeKind_ECR_Repository(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewGeneratorForService(t, "ecr")
+
+	crd := testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+
+	expected := `&kind{
+	name: "Repository",
+	keyMembers: []string{"RepositoryName"},
+	inputWrapper: "",
+	aliases: map[string]string{},
+	readOnlyAttributes: []string{},
+	readyMember: "",
+	readyValue: "",
+	notFoundCode: "RepositoryNotFoundException",
+	notFoundMessage: "Repository not found",
+	alreadyExistsCode: "RepositoryAlreadyExistsException",
+}`
+	assert.Equal(expected, code.FakeKind(crd.Config(), crd, 0))
+	assert.Equal(`[]string{"Repository"}`, code.FakeOutputWrapperPath(crd, "Create"))
+	// The resource has no Update operation
+	assert.Equal("nil", code.FakeOutputWrapperPath(crd, "Update"))
+}
+
+func TestFakeKind_RDS_DBInstance_Readiness(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewGeneratorForService(t, "rds")
+
+	crd := testutil.GetCRDByName(t, g, "DBInstance")
+	require.NotNil(crd)
+
+	// Created records are given the first ready lifecycle status
+	kind := code.FakeKind(crd.Config(), crd, 0)
+	assert.Contains(kind, `readyMember: "DBInstanceStatus",`)
+	assert.Contains(kind, `readyValue: "available",`)
+	assert.Contains(kind, `keyMembers: []string{"DBInstanceIdentifier"},`)
+}
+
+func TestFakeKind_S3_Bucket_Renames(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewGeneratorForService(t, "s3")
+
+	crd := testutil.GetCRDByName(t, g, "Bucket")
+	require.NotNil(crd)
+
+	expected := `	aliases: map[string]string{
+		"Name": "Bucket",
+	},
+`
+	assert.Contains(code.FakeKind(crd.Config(), crd, 0), expected)
+}
+
+func TestFakeKind_SQS_Queue_ReadOnlyAttributes(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewGeneratorForService(t, "sqs")
+
+	crd := testutil.GetCRDByName(t, g, "Queue")
+	require.NotNil(crd)
+
+	kind := code.FakeKind(crd.Config(), crd, 0)
+	assert.Contains(kind, `keyMembers: []string{"QueueUrl"},`)
+	assert.Contains(
+		kind,
+		`readOnlyAttributes: []string{"CreatedTimestamp", "LastModifiedTimestamp", "QueueArn"},`,
+	)
+}
+
+func TestFakeKind_SageMaker_TrainingJob_MessagePrefix(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewGeneratorForService(t, "sagemaker")
+
+	crd := testutil.GetCRDByName(t, g, "TrainingJob")
+	require.NotNil(crd)
+
+	// The generated controller only treats exceptions with the configured
+	// message prefix as NotFound
+	kind := code.FakeKind(crd.Config(), crd, 0)
+	assert.Contains(kind, `notFoundCode: "ValidationException",`)
+	assert.Contains(
+		kind,
+		`notFoundMessage: "Requested resource not found TrainingJob not found",`,
+	)
+}
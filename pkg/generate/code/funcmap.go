@@ -0,0 +1,162 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code
+
+import (
+	"strings"
+	ttpl "text/template"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
+)
+
+// FuncMap returns the template functions that output Go code for a resource.
+// Every target's templates, including custom templates, can use them.
+//
+// The `GoCodeSetSDK`, `GoCodeSetResource`, `GoCodeCompareResource` and
+// `GoCodeRequiredFieldsMissing` functions accept Options constructed with
+// `EmitterOptions` and can target any API operation, e.g.:
+//
+//   {{ GoCodeSetSDK .CRD (EmitterOptions "Op" "TagResource" "SourceVarName" "r.ko" "TargetVarName" "input" "IndentLevel" 1) }}
+//
+// The other `GoCode*` functions target one of the resource's operations.
+func FuncMap() ttpl.FuncMap {
+	return ttpl.FuncMap{
+		"ToLower": strings.ToLower,
+		"Empty": func(subject string) bool {
+			return strings.TrimSpace(subject) == ""
+		},
+		"ResourceExceptionCode": func(r *model.CRD, httpStatusCode int) string {
+			return r.ExceptionCode(httpStatusCode)
+		},
+		"ListMemberNameInReadManyOutput": func(r *model.CRD) string {
+			return ListMemberNameInReadManyOutput(r)
+		},
+		"SampleInputMembers": func(r *model.CRD, opName string) []SampleInputMember {
+			return SampleInputMembers(r, opName)
+		},
+		"SpecFieldNamesSetFromReadOutput": func(r *model.CRD) []string {
+			return SpecFieldNamesSetFromReadOutput(r)
+		},
+		"EmitterOptions": NewOptions,
+		"GoCodeSetSDK": func(r *model.CRD, opts Options) string {
+			return NewEmitter(r).SetSDK(opts)
+		},
+		"GoCodeSetResource": func(r *model.CRD, opts Options) string {
+			return NewEmitter(r).SetResource(opts)
+		},
+		"GoCodeCompareResource": func(r *model.CRD, opts Options) string {
+			return NewEmitter(r).CompareResource(opts)
+		},
+		"GoCodeRequiredFieldsMissing": func(r *model.CRD, opts Options) string {
+			return NewEmitter(r).RequiredFieldsMissing(opts)
+		},
+		"GoCodeSetExceptionMessageCheck": func(r *model.CRD, httpStatusCode int) string {
+			return CheckExceptionMessage(r.Config(), r, httpStatusCode)
+		},
+		"GoCodeSetReadOneOutput":      setResourceFunc("ReadOne"),
+		"GoCodeSetReadOneInput":       setSDKFunc("ReadOne"),
+		"GoCodeSetReadManyOutput":     setResourceFunc("ReadMany"),
+		"GoCodeSetReadManyInput":      setSDKFunc("ReadMany"),
+		"GoCodeGetAttributesSetInput": setSDKFunc("GetAttributes"),
+		"GoCodeSetAttributesSetInput": setSDKFunc("SetAttributes"),
+		"GoCodeGetAttributesSetOutput": func(r *model.CRD, sourceVarName string, targetVarName string, indentLevel int) string {
+			return NewEmitter(r).SetResource(Options{
+				Op:            "GetAttributes",
+				SourceVarName: sourceVarName,
+				TargetVarName: targetVarName,
+				IndentLevel:   indentLevel,
+			})
+		},
+		"GoCodeSetCreateOutput": setResourceFunc("Create"),
+		"GoCodeSetCreateInput":  setSDKFunc("Create"),
+		"GoCodeSetUpdateOutput": setResourceFunc("Update"),
+		"GoCodeSetUpdateInput":  setSDKFunc("Update"),
+		"GoCodeSetDeleteInput":  setSDKFunc("Delete"),
+		"GoCodeSetActionInput": func(r *model.CRD, action *model.Action, sourceVarName string, targetVarName string, indentLevel int) string {
+			return SetSDKAction(r.Config(), r, action, sourceVarName, targetVarName, indentLevel)
+		},
+		"GoCodeSetResourceIdentifiers": func(r *model.CRD, sourceVarName string, targetVarName string, indentLevel int) string {
+			return SetResourceIdentifiers(r.Config(), r, sourceVarName, targetVarName, indentLevel)
+		},
+		"GoCodeLifecycleStatus": func(r *model.CRD, koVarName string, indentLevel int) string {
+			return LifecycleStatus(r.Config(), r, koVarName, indentLevel)
+		},
+		"GoCodeCompare": func(r *model.CRD, deltaVarName string, sourceVarName string, targetVarName string, indentLevel int) string {
+			return NewEmitter(r).CompareResource(Options{
+				DeltaVarName:  deltaVarName,
+				SourceVarName: sourceVarName,
+				TargetVarName: targetVarName,
+				IndentLevel:   indentLevel,
+			})
+		},
+		"GoCodeSampleValue": func(r *model.CRD, fieldName string, variant int) string {
+			return SampleValue(r.Config(), r, fieldName, variant)
+		},
+		"GoCodeFakeKind": func(r *model.CRD, indentLevel int) string {
+			return FakeKind(r.Config(), r, indentLevel)
+		},
+		"GoCodeFakeOutputWrapperPath": func(r *model.CRD, opName string) string {
+			return FakeOutputWrapperPath(r, opName)
+		},
+		"GoCodeRequiredFieldsMissingFromReadOneInput":       requiredFieldsMissingFunc("ReadOne"),
+		"GoCodeRequiredFieldsMissingFromGetAttributesInput": requiredFieldsMissingFunc("GetAttributes"),
+		"GoCodeRequiredFieldsMissingFromSetAttributesInput": requiredFieldsMissingFunc("SetAttributes"),
+	}
+}
+
+// setSDKFunc returns a template function that outputs the Go code setting the
+// Input shape of the supplied resource operation from a CR
+func setSDKFunc(
+	opName string,
+) func(*model.CRD, string, string, int) string {
+	return func(r *model.CRD, sourceVarName string, targetVarName string, indentLevel int) string {
+		return NewEmitter(r).SetSDK(Options{
+			Op:            opName,
+			SourceVarName: sourceVarName,
+			TargetVarName: targetVarName,
+			IndentLevel:   indentLevel,
+		})
+	}
+}
+
+// setResourceFunc returns a template function that outputs the Go code
+// setting a CR from the Output shape of the supplied resource operation
+func setResourceFunc(
+	opName string,
+) func(*model.CRD, string, string, int, bool) string {
+	return func(r *model.CRD, sourceVarName string, targetVarName string, indentLevel int, performSpecUpdate bool) string {
+		return NewEmitter(r).SetResource(Options{
+			Op:                opName,
+			SourceVarName:     sourceVarName,
+			TargetVarName:     targetVarName,
+			IndentLevel:       indentLevel,
+			PerformSpecUpdate: performSpecUpdate,
+		})
+	}
+}
+
+// requiredFieldsMissingFunc returns a template function that outputs the Go
+// code of a condition checking that the CR's fields for the required members
+// of the Input shape of the supplied resource operation are set
+func requiredFieldsMissingFunc(
+	opName string,
+) func(*model.CRD, string, int) string {
+	return func(r *model.CRD, koVarName string, indentLevel int) string {
+		return NewEmitter(r).RequiredFieldsMissing(Options{
+			Op:            opName,
+			SourceVarName: koVarName,
+			IndentLevel:   indentLevel,
+		})
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code
+
+import (
+	"fmt"
+	"strings"
+
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/generate/config"
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
+)
+
+// LifecycleStatus returns the Go code that returns the value of the
+// resource's lifecycle status field, as configured in the `readiness` block
+// of the generator config, or the empty string if the field (or any of its
+// containing structs) is nil.
+//
+// Sample output for a `readiness.path` of `State.Name`:
+//
+//   if ko.Status.State == nil || ko.Status.State.Name == nil {
+//       return ""
+//   }
+//   return *ko.Status.State.Name
+func LifecycleStatus(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// String representing the name of the variable holding the custom
+	// resource, e.g. "ko"
+	koVarName string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	readiness := r.Readiness()
+	if readiness == nil {
+		return ""
+	}
+	if len(readiness.Ready) == 0 {
+		msg := fmt.Sprintf(
+			"GENERATION FAILURE! readiness config for resource %s must "+
+				"specify at least one ready value",
+			r.Names.Original,
+		)
+		panic(msg)
+	}
+	listedAs := map[string]string{}
+	for _, list := range []struct {
+		name   string
+		values []string
+	}{
+		{"ready", readiness.Ready},
+		{"in_progress", readiness.InProgress},
+		{"failed", readiness.Failed},
+		{"deleting", readiness.Deleting},
+	} {
+		for _, value := range list.values {
+			if prev, found := listedAs[value]; found {
+				msg := fmt.Sprintf(
+					"GENERATION FAILURE! readiness config for resource %s "+
+						"lists value %q as both %s and %s",
+					r.Names.Original, value, prev, list.name,
+				)
+				panic(msg)
+			}
+			listedAs[value] = list.name
+		}
+	}
+	fields := r.FieldsAlongPath(readiness.Path)
+	if len(fields) == 0 {
+		msg := fmt.Sprintf(
+			"GENERATION FAILURE! readiness path %s does not refer to a "+
+				"field of resource %s",
+			readiness.Path, r.Names.Original,
+		)
+		panic(msg)
+	}
+	leaf := fields[len(fields)-1]
+	if leaf.GoType != "*string" {
+		msg := fmt.Sprintf(
+			"GENERATION FAILURE! readiness path %s of resource %s must "+
+				"refer to a string field, but has type %s",
+			readiness.Path, r.Names.Original, leaf.GoType,
+		)
+		panic(msg)
+	}
+
+	indent := strings.Repeat("\t", indentLevel)
+	varPath := koVarName
+	if r.IsSpecField(fields[0]) {
+		varPath += cfg.PrefixConfig.SpecField
+	} else {
+		varPath += cfg.PrefixConfig.StatusField
+	}
+	nilChecks := []string{}
+	for _, f := range fields {
+		varPath += "." + f.Names.Camel
+		nilChecks = append(nilChecks, varPath+" == nil")
+	}
+	out := fmt.Sprintf(
+		"%sif %s {\n", indent, strings.Join(nilChecks, " || "),
+	)
+	out += fmt.Sprintf("%s\treturn \"\"\n", indent)
+	out += fmt.Sprintf("%s}\n", indent)
+	out += fmt.Sprintf("%sreturn *%s\n", indent, varPath)
+	return out
+}
@@ -0,0 +1,77 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	 http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/generate/code"
+	"github.com/aws-controllers-k8s/code-generator/pkg/testutil"
+)
+
+func TestLifecycleStatus_RDS_DBInstance(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewGeneratorForService(t, "rds")
+
+	crd := testutil.GetCRDByName(t, g, "DBInstance")
+	require.NotNil(crd)
+	require.NotNil(crd.Readiness())
+
+	expected := `	if ko.Status.DBInstanceStatus == nil {
+		return ""
+	}
+	return *ko.Status.DBInstanceStatus
+`
+	assert.Equal(
+		expected,
+		code.LifecycleStatus(crd.Config(), crd, "ko", 1),
+	)
+}
+
+func TestLifecycleStatus_NoReadiness(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewGeneratorForService(t, "rds")
+
+	crd := testutil.GetCRDByName(t, g, "DBSubnetGroup")
+	require.NotNil(crd)
+	require.Nil(crd.Readiness())
+
+	assert.Equal("", code.LifecycleStatus(crd.Config(), crd, "ko", 1))
+}
+
+func TestLifecycleStatus_OverlappingValues(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewGeneratorForService(t, "rds")
+
+	crd := testutil.GetCRDByName(t, g, "DBInstance")
+	require.NotNil(crd)
+	readiness := crd.Readiness()
+	require.NotNil(readiness)
+
+	// A value may only be listed once across ready, in_progress and failed
+	readiness.Failed = append(readiness.Failed, "creating")
+	assert.Panics(func() { code.LifecycleStatus(crd.Config(), crd, "ko", 1) })
+	readiness.Failed = []string{"failed"}
+	readiness.Ready = append(readiness.Ready, "available")
+	assert.Panics(func() { code.LifecycleStatus(crd.Config(), crd, "ko", 1) })
+}
@@ -0,0 +1,387 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	awssdkmodel "github.com/aws/aws-sdk-go/private/model/api"
+
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/generate/config"
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
+	"github.com/aws-controllers-k8s/code-generator/pkg/names"
+)
+
+// SampleValue returns a Go expression evaluating to a sample value for the
+// supplied Spec field of the resource, derived from the field's shape in the
+// API model. Generated tests use sample values to populate a CR. Different
+// variants of the same field always have different values.
+//
+// The empty string is returned for fields that can't be populated without a
+// Kubernetes API server, i.e. SecretKeyReference fields.
+//
+// Output code will look something like this:
+//
+//   &svcapitypes.ImageScanningConfiguration{
+//       ScanOnPush: aws.Bool(true),
+//   }
+func SampleValue(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// The name of the Spec field, e.g. "ImageScanningConfiguration"
+	fieldName string,
+	// The variant of the sample value. Variant 0 of an enum field is the
+	// first value of the enum, variant 1 the second, and so on.
+	variant int,
+) string {
+	f, found := r.SpecFields[fieldName]
+	if !found {
+		msg := fmt.Sprintf(
+			"GENERATION FAILURE! resource %s has no Spec field %s",
+			r.Names.Original, fieldName,
+		)
+		panic(msg)
+	}
+	if r.IsSecretField(fieldName) {
+		return ""
+	}
+	if f.IsJSON() {
+		return sampleJSON(variant)
+	}
+	if f.ShapeRef == nil {
+		// Fields unpacked from an Attributes map have no shape and are
+		// always strings
+		return sampleString(f.Names.Camel, nil, variant)
+	}
+	return sampleValueForShape(
+		r, f.ShapeRef, f.Names.Camel, f.Names.Camel, variant, map[string]bool{},
+	)
+}
+
+// sampleValueForShape returns a Go expression evaluating to a sample value
+// of the Go type that the API types use for the supplied shape, or the empty
+// string if no value can be sampled
+func sampleValueForShape(
+	r *model.CRD,
+	shapeRef *awssdkmodel.ShapeRef,
+	// The name of the field or struct member the value is for
+	name string,
+	// The path to the field, e.g. "Rules..Port", used to find field configs
+	fieldPath string,
+	variant int,
+	// The names of the struct shapes containing the value, which stops
+	// recursive shapes from being sampled forever
+	containers map[string]bool,
+) string {
+	shape := shapeRef.Shape
+	if shapeRef.JSONValue {
+		// Nested JSON documents have no sample Go type
+		return ""
+	}
+	switch shape.Type {
+	case "structure":
+		return sampleStruct(r, shape, fieldPath, variant, containers)
+	case "list":
+		elemValue := sampleValueForShape(
+			r, &shape.MemberRef, name, fieldPath+".", variant, containers,
+		)
+		if elemValue == "" {
+			return ""
+		}
+		return fmt.Sprintf(
+			"%s{%s}", sampleGoType(r, shapeRef), elemValue,
+		)
+	case "map":
+		valValue := sampleValueForShape(
+			r, &shape.ValueRef, name, fieldPath+".", variant, containers,
+		)
+		if valValue == "" {
+			return ""
+		}
+		return fmt.Sprintf(
+			"%s{%q: %s}",
+			sampleGoType(r, shapeRef),
+			fmt.Sprintf("key-%d", variant),
+			valValue,
+		)
+	case "string", "character":
+		return sampleString(name, shape.Enum, variant)
+	case "boolean":
+		return fmt.Sprintf("aws.Bool(%t)", variant%2 == 0)
+	case "byte", "short", "integer", "long":
+		return fmt.Sprintf("aws.Int64(%d)", int64(shape.Min)+int64(variant)+1)
+	case "float", "double":
+		return fmt.Sprintf("aws.Float64(%d.5)", int64(shape.Min)+int64(variant))
+	case "timestamp":
+		return fmt.Sprintf(
+			"&metav1.Time{Time: time.Date(2021, time.January, %d, 0, 0, 0, 0, time.UTC)}",
+			variant+1,
+		)
+	case "blob":
+		return fmt.Sprintf("[]byte(%q)", fmt.Sprintf("%s-%d", name, variant))
+	}
+	return ""
+}
+
+// sampleStruct returns a Go expression evaluating to a pointer to a struct
+// with every member that can be sampled set to a sample value. Only the
+// first such member of a union is set.
+func sampleStruct(
+	r *model.CRD,
+	shape *awssdkmodel.Shape,
+	// The path to the struct field, e.g. "Rules."
+	fieldPath string,
+	variant int,
+	containers map[string]bool,
+) string {
+	out := fmt.Sprintf("&svcapitypes.%s{\n", sampleStructTypeName(r, shape))
+	if containers[shape.ShapeName] {
+		return out + "}"
+	}
+	memberContainers := map[string]bool{shape.ShapeName: true}
+	for name := range containers {
+		memberContainers[name] = true
+	}
+	isUnion := r.IsUnionShape(shape)
+	for _, memberName := range shape.MemberNames() {
+		memberNames := names.New(memberName)
+		memberFieldPath := fieldPath + "." + memberNames.Camel
+		if r.IsSecretField(memberFieldPath) {
+			continue
+		}
+		memberValue := sampleValueForShape(
+			r, shape.MemberRefs[memberName], memberNames.Camel,
+			memberFieldPath, variant, memberContainers,
+		)
+		if memberValue == "" {
+			continue
+		}
+		out += fmt.Sprintf("%s: %s,\n", memberNames.Camel, memberValue)
+		if isUnion {
+			break
+		}
+	}
+	return out + "}"
+}
+
+// sampleString returns a Go expression evaluating to a pointer to a sample
+// string. Enum-backed strings take the enum's values in turn.
+func sampleString(
+	name string,
+	enum []string,
+	variant int,
+) string {
+	if variant < len(enum) {
+		return fmt.Sprintf("aws.String(%q)", enum[variant])
+	}
+	return fmt.Sprintf("aws.String(%q)", fmt.Sprintf("%s-%d", name, variant))
+}
+
+// sampleJSON returns a Go expression evaluating to a sample JSON document
+// field value
+func sampleJSON(variant int) string {
+	return fmt.Sprintf(
+		"&apiextensionsv1.JSON{Raw: []byte(%s)}",
+		strconv.Quote(fmt.Sprintf(`{"key":"value-%d"}`, variant)),
+	)
+}
+
+// sampleGoType returns the Go type that the API types use for the supplied
+// shape, e.g. "[]*svcapitypes.Tag" for a list of Tag structs
+func sampleGoType(
+	r *model.CRD,
+	shapeRef *awssdkmodel.ShapeRef,
+) string {
+	shape := shapeRef.Shape
+	switch shape.Type {
+	case "structure":
+		return "*svcapitypes." + sampleStructTypeName(r, shape)
+	case "list":
+		return "[]" + sampleGoType(r, &shape.MemberRef)
+	case "map":
+		return "map[string]" + sampleGoType(r, &shape.ValueRef)
+	case "timestamp":
+		return "*metav1.Time"
+	}
+	return shapeRef.GoType()
+}
+
+// sampleStructTypeName returns the name of the API type for the supplied
+// struct shape
+func sampleStructTypeName(
+	r *model.CRD,
+	shape *awssdkmodel.Shape,
+) string {
+	if renamed, found := r.TypeRenames()[shape.ShapeName]; found {
+		return renamed
+	}
+	return names.New(shape.ShapeName).Camel
+}
+
+// outputWrapperPath returns the names of the members wrapping the resource's
+// fields in the Output shape of the supplied operation, unwrapped the same way
+// the code setting a CR from the Output shape unwraps it. An empty slice is
+// returned if the Output shape isn't wrapped.
+func outputWrapperPath(
+	r *model.CRD,
+	op *awssdkmodel.Operation,
+) []string {
+	_, wrapperPath := unwrapOutputShape(r, op)
+	if wrapperPath == "" {
+		return []string{}
+	}
+	return strings.Split(strings.TrimPrefix(wrapperPath, "."), ".")
+}
+
+// SampleInputMember is a member of an API operation's Input shape that the Go
+// code returned by SetSDK sets from a Spec field of the resource. Generated
+// tests check the member against the sample value of the Spec field.
+type SampleInputMember struct {
+	// Path is the path to the member from the Input shape, e.g.
+	// "CachePolicyConfig.Comment" for a member of an unwrapped Input member
+	Path string
+	// FieldName is the name of the Spec field the member is set from, e.g.
+	// "Comment"
+	FieldName string
+	// Comparable is true if the member has the same Go type as the Spec
+	// field, i.e. its shape has no structure, timestamp or JSON value in it.
+	// Members that aren't comparable are only checked for being set.
+	Comparable bool
+}
+
+// SampleInputMembers returns the members of the Input shape of the supplied
+// resource operation, e.g. "Create", that are set from a Spec field with a
+// sample value, sorted by member path
+func SampleInputMembers(
+	r *model.CRD,
+	opName string,
+) []SampleInputMember {
+	op := r.Operation(opName)
+	if op == nil || op.InputRef.Shape == nil {
+		return []SampleInputMember{}
+	}
+	cfg := r.Config()
+	opConfig, override := cfg.OverrideValues(op.Name)
+	res := []SampleInputMember{}
+	var addMembers func(shape *awssdkmodel.Shape, pathPrefix string)
+	addMembers = func(shape *awssdkmodel.Shape, pathPrefix string) {
+		for _, memberName := range shape.MemberNames() {
+			memberRef := shape.MemberRefs[memberName]
+			if r.UnpacksAttributesMap() && memberName == "Attributes" {
+				continue
+			}
+			if pathPrefix == "" && r.IsUnwrappedInputMember(memberName, memberRef) {
+				addMembers(memberRef.Shape, memberName+".")
+				continue
+			}
+			if _, ok := opConfig[memberName]; override && ok {
+				continue
+			}
+			if r.IsPrimaryARNField(memberName) || r.IsSingletonAccountIDField(memberName) {
+				continue
+			}
+			renamedName, _ := r.InputFieldRename(op.Name, memberName)
+			f, found := r.SpecFields[renamedName]
+			if !found || SampleValue(cfg, r, renamedName, 0) == "" {
+				continue
+			}
+			res = append(res, SampleInputMember{
+				Path:       pathPrefix + memberName,
+				FieldName:  f.Names.Camel,
+				Comparable: !f.IsJSON() && isSampleComparableShape(memberRef, 0),
+			})
+		}
+	}
+	addMembers(op.InputRef.Shape, "")
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Path < res[j].Path
+	})
+	return res
+}
+
+// isSampleComparableShape returns true if the API types and the aws-sdk-go
+// use the same Go type for the supplied shape, i.e. a scalar or a list or
+// map of scalars
+func isSampleComparableShape(
+	shapeRef *awssdkmodel.ShapeRef,
+	depth int,
+) bool {
+	if shapeRef.JSONValue {
+		return false
+	}
+	shape := shapeRef.Shape
+	switch shape.Type {
+	case "list":
+		return depth == 0 && isSampleComparableShape(&shape.MemberRef, depth+1)
+	case "map":
+		return depth == 0 && isSampleComparableShape(&shape.ValueRef, depth+1)
+	case "string", "character", "boolean", "byte", "short", "integer",
+		"long", "float", "double", "blob":
+		return true
+	}
+	return false
+}
+
+// SpecFieldNamesSetFromReadOutput returns the names of the Spec fields that
+// the Go code returned by SetResource for the resource's read operation sets
+// from the operation's Output shape, sorted by name. The read operation is
+// the first of the ReadOne, GetAttributes and ReadMany operations that the
+// resource has. Spec fields that aren't in the Output shape are never read
+// back from the API.
+func SpecFieldNamesSetFromReadOutput(
+	r *model.CRD,
+) []string {
+	var op *awssdkmodel.Operation
+	var outputShape *awssdkmodel.Shape
+	switch {
+	case r.Ops.ReadOne != nil:
+		op = r.Ops.ReadOne
+		outputShape, _ = unwrapOutputShape(r, op)
+	case r.Ops.GetAttributes != nil:
+		// Only Status fields are set from the Attributes map
+		return []string{}
+	case r.Ops.ReadMany != nil:
+		op = r.Ops.ReadMany
+		listMemberName := ListMemberNameInReadManyOutput(r)
+		outputShape = op.OutputRef.Shape.MemberRefs[listMemberName].Shape.MemberRef.Shape
+	}
+	if outputShape == nil {
+		return []string{}
+	}
+	res := []string{}
+	var addFields func(shape *awssdkmodel.Shape, unwrap bool)
+	addFields = func(shape *awssdkmodel.Shape, unwrap bool) {
+		for _, memberName := range shape.MemberNames() {
+			memberRef := shape.MemberRefs[memberName]
+			if unwrap && r.IsUnwrappedInputMember(memberName, memberRef) {
+				addFields(memberRef.Shape, false)
+				continue
+			}
+			if r.IsPrimaryARNField(memberName) {
+				continue
+			}
+			renamedName, _ := r.InputFieldRename(op.Name, memberName)
+			if _, found := r.SpecFields[renamedName]; found {
+				res = append(res, renamedName)
+			}
+		}
+	}
+	// Only the ReadOne Output shape has the unwrapped Input member
+	addFields(outputShape, op == r.Ops.ReadOne)
+	sort.Strings(res)
+	return res
+}
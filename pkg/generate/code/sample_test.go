@@ -0,0 +1,183 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/generate/code"
+	"github.com/aws-controllers-k8s/code-generator/pkg/testutil"
+)
+
+func TestSampleValue_ECR_Repository(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewGeneratorForService(t, "ecr")
+
+	crd := testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+
+	assert.Equal(
+		`aws.String("RepositoryName-0")`,
+		code.SampleValue(crd.Config(), crd, "RepositoryName", 0),
+	)
+	// Enum-backed strings take the enum's values in turn
+	assert.Equal(
+		`aws.String("MUTABLE")`,
+		code.SampleValue(crd.Config(), crd, "ImageTagMutability", 0),
+	)
+	assert.Equal(
+		`aws.String("IMMUTABLE")`,
+		code.SampleValue(crd.Config(), crd, "ImageTagMutability", 1),
+	)
+	assert.Equal(
+		`aws.String("ImageTagMutability-2")`,
+		code.SampleValue(crd.Config(), crd, "ImageTagMutability", 2),
+	)
+
+	expected := `&svcapitypes.ImageScanningConfiguration{
+ScanOnPush: aws.Bool(false),
+}`
+	assert.Equal(
+		expected,
+		code.SampleValue(crd.Config(), crd, "ImageScanningConfiguration", 1),
+	)
+
+	expected = `[]*svcapitypes.Tag{&svcapitypes.Tag{
+Key: aws.String("Key-0"),
+Value: aws.String("Value-0"),
+}}`
+	assert.Equal(
+		expected,
+		code.SampleValue(crd.Config(), crd, "Tags", 0),
+	)
+}
+
+func TestSampleValue_SQS_Queue(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewGeneratorForService(t, "sqs")
+
+	crd := testutil.GetCRDByName(t, g, "Queue")
+	require.NotNil(crd)
+
+	// Fields unpacked from the Attributes map are strings
+	assert.Equal(
+		`aws.String("DelaySeconds-0")`,
+		code.SampleValue(crd.Config(), crd, "DelaySeconds", 0),
+	)
+	assert.Equal(
+		"&apiextensionsv1.JSON{Raw: []byte(\"{\\\"key\\\":\\\"value-1\\\"}\")}",
+		code.SampleValue(crd.Config(), crd, "Policy", 1),
+	)
+}
+
+func TestSampleValue_ElastiCache_User_Secret(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewGeneratorForService(t, "elasticache")
+
+	crd := testutil.GetCRDByName(t, g, "User")
+	require.NotNil(crd)
+
+	// SecretKeyReference fields aren't sampled
+	assert.Equal("", code.SampleValue(crd.Config(), crd, "Passwords", 0))
+	assert.Panics(func() {
+		code.SampleValue(crd.Config(), crd, "Nonexistent", 0)
+	})
+}
+
+func TestSampleInputMembers_ECR_Repository(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewGeneratorForService(t, "ecr")
+
+	crd := testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+
+	// Struct and list of struct members have different Go types in the API
+	// types and aws-sdk-go, so they are only checked for being set
+	expected := []code.SampleInputMember{
+		{Path: "ImageScanningConfiguration", FieldName: "ImageScanningConfiguration"},
+		{Path: "ImageTagMutability", FieldName: "ImageTagMutability", Comparable: true},
+		{Path: "RepositoryName", FieldName: "RepositoryName", Comparable: true},
+		{Path: "Tags", FieldName: "Tags"},
+	}
+	assert.Equal(expected, code.SampleInputMembers(crd, "Create"))
+}
+
+func TestSampleInputMembers_CloudFront_CachePolicy(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewGeneratorForService(t, "cloudfront")
+
+	crd := testutil.GetCRDByName(t, g, "CachePolicy")
+	require.NotNil(crd)
+
+	// The members of the unwrapped CachePolicyConfig member are set from
+	// top-level Spec fields
+	members := code.SampleInputMembers(crd, "Create")
+	require.Len(members, 6)
+	assert.Equal(
+		code.SampleInputMember{
+			Path:       "CachePolicyConfig.Comment",
+			FieldName:  "Comment",
+			Comparable: true,
+		},
+		members[0],
+	)
+}
+
+func TestSpecFieldNamesSetFromReadOutput(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	// The DescribeRepositories Output shape has no tags, so Spec.Tags is
+	// never read back
+	g := testutil.NewGeneratorForService(t, "ecr")
+	crd := testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+	assert.Equal(
+		[]string{"ImageScanningConfiguration", "ImageTagMutability", "RepositoryName"},
+		code.SpecFieldNamesSetFromReadOutput(crd),
+	)
+
+	// The members of the unwrapped CachePolicyConfig member of the
+	// GetCachePolicy Output shape are read back
+	g = testutil.NewGeneratorForService(t, "cloudfront")
+	crd = testutil.GetCRDByName(t, g, "CachePolicy")
+	require.NotNil(crd)
+	assert.Equal(
+		[]string{
+			"Comment", "DefaultTTL", "MaxTTL", "MinTTL", "Name",
+			"ParametersInCacheKeyAndForwardedToOrigin",
+		},
+		code.SpecFieldNamesSetFromReadOutput(crd),
+	)
+
+	// Only Status fields are read back from the GetTopicAttributes Output
+	// shape
+	g = testutil.NewGeneratorForService(t, "sns")
+	crd = testutil.GetCRDByName(t, g, "Topic")
+	require.NotNil(crd)
+	assert.Empty(code.SpecFieldNamesSetFromReadOutput(crd))
+}
@@ -100,7 +100,7 @@ func SetResource(
 		op = r.Ops.ReadOne
 	case model.OpTypeList:
 		return setResourceReadMany(
-			cfg, r,
+			cfg, r, DefaultErrorReturn,
 			r.Ops.ReadMany, sourceVarName, targetVarName, indentLevel,
 		)
 	case model.OpTypeUpdate:
@@ -110,13 +110,55 @@ func SetResource(
 	default:
 		return ""
 	}
+	return setResourceForOperation(
+		cfg, r, DefaultErrorReturn, op, sourceVarName, targetVarName,
+		indentLevel, performSpecUpdate,
+	)
+}
+
+// setResourceForOperation returns the Go code that sets a CRD's field value
+// from the value of an output shape's member fields of the supplied
+// operation. See SetResource.
+func setResourceForOperation(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// The statement returning an error from the enclosing function
+	errorReturn string,
+	op *awssdkmodel.Operation,
+	sourceVarName string,
+	targetVarName string,
+	indentLevel int,
+	performSpecUpdate bool,
+) string {
 	if op == nil {
 		return ""
 	}
-	outputShape := op.OutputRef.Shape
+	outputShape, wrapperPath := unwrapOutputShape(r, op)
 	if outputShape == nil {
 		return ""
 	}
+	sourceVarName += wrapperPath
+	out := "\n"
+	out += setResourceForShapeMembers(
+		cfg, r, errorReturn, op, outputShape, sourceVarName, targetVarName, "",
+		indentLevel, performSpecUpdate,
+	)
+	return out
+}
+
+// unwrapOutputShape returns the shape of the supplied operation's Output
+// shape that represents the resource, along with the path to it from the
+// Output shape, e.g. ".CachePolicy", or the empty string if the Output shape
+// itself represents the resource. nil is returned if the operation has no
+// Output shape.
+func unwrapOutputShape(
+	r *model.CRD,
+	op *awssdkmodel.Operation,
+) (*awssdkmodel.Shape, string) {
+	outputShape := op.OutputRef.Shape
+	if outputShape == nil {
+		return nil, ""
+	}
 
 	var err error
 	// We might be in a "wrapper" shape. Unwrap it to find the real object
@@ -130,21 +172,41 @@ func SetResource(
 			msg := fmt.Sprintf("Unable to unwrap the output shape: %v", err)
 			panic(msg)
 		}
-		sourceVarName += "." + *wrapperFieldPath
-	} else {
-		// If the wrapper field path is not specified in the config file and if
-		// there is a single member shape and that member shape is a structure,
-		// unwrap it.
-		if outputShape.UsedAsOutput && len(outputShape.MemberRefs) == 1 {
-			for memberName, memberRef := range outputShape.MemberRefs {
-				if memberRef.Shape.Type == "structure" {
-					sourceVarName += "." + memberName
-					outputShape = memberRef.Shape
-				}
+		return outputShape, "." + *wrapperFieldPath
+	}
+	// If the wrapper field path is not specified in the config file and if
+	// there is a single member shape and that member shape is a structure,
+	// unwrap it.
+	if outputShape.UsedAsOutput && len(outputShape.MemberRefs) == 1 {
+		for memberName, memberRef := range outputShape.MemberRefs {
+			if memberRef.Shape.Type == "structure" {
+				return memberRef.Shape, "." + memberName
 			}
 		}
 	}
-	out := "\n"
+	return outputShape, ""
+}
+
+// setResourceForShapeMembers returns the Go code that sets a CRD's fields
+// from the member fields of the supplied Output shape. When the CRD unwraps
+// its Input shape, the members of the wrapper member of the Output shape are
+// set into the corresponding top-level Spec fields. varPrefix is prepended to
+// the names of temporary variables, which keeps them unique within the
+// wrapper.
+func setResourceForShapeMembers(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// The statement returning an error from the enclosing function
+	errorReturn string,
+	op *awssdkmodel.Operation,
+	outputShape *awssdkmodel.Shape,
+	sourceVarName string,
+	targetVarName string,
+	varPrefix string,
+	indentLevel int,
+	performSpecUpdate bool,
+) string {
+	out := ""
 	indent := strings.Repeat("\t", indentLevel)
 
 	// Recursively descend down through the set of fields on the Output shape,
@@ -154,6 +216,34 @@ func SetResource(
 		//TODO: (vijat@) should these field be renamed before looking them up in spec?
 		sourceAdaptedVarName := sourceVarName + "." + memberName
 
+		if varPrefix == "" && r.IsUnwrappedInputMember(
+			memberName, outputShape.MemberRefs[memberName],
+		) {
+			// The wrapper's members are top-level Spec fields:
+			//
+			// if resp.CachePolicy.CachePolicyConfig != nil {
+			//     if resp.CachePolicy.CachePolicyConfig.Comment != nil {
+			//         ko.Spec.Comment = resp.CachePolicy.CachePolicyConfig.Comment
+			//     } else {
+			//         ko.Spec.Comment = nil
+			//     }
+			// }
+			out += fmt.Sprintf(
+				"%sif %s != nil {\n", indent, sourceAdaptedVarName,
+			)
+			out += setResourceForShapeMembers(
+				cfg, r, errorReturn, op,
+				outputShape.MemberRefs[memberName].Shape,
+				sourceAdaptedVarName,
+				targetVarName,
+				fmt.Sprintf("f%d", memberIndex),
+				indentLevel+1,
+				performSpecUpdate,
+			)
+			out += fmt.Sprintf("%s}\n", indent)
+			continue
+		}
+
 		// Handle the special case of ARN for primary resource identifier
 		if r.IsPrimaryARNField(memberName) {
 			// if ko.Status.ACKResourceMetadata == nil {
@@ -268,7 +358,7 @@ func SetResource(
 		switch sourceMemberShape.Type {
 		case "list", "structure", "map":
 			{
-				memberVarName := fmt.Sprintf("f%d", memberIndex)
+				memberVarName := fmt.Sprintf("%sf%d", varPrefix, memberIndex)
 				out += varEmptyConstructorK8sType(
 					cfg, r,
 					memberVarName,
@@ -276,7 +366,7 @@ func SetResource(
 					indentLevel+1,
 				)
 				out += setResourceForContainer(
-					cfg, r,
+					cfg, r, errorReturn,
 					f.Names.Camel,
 					memberVarName,
 					targetMemberShapeRef,
@@ -294,20 +384,31 @@ func SetResource(
 				)
 			}
 		default:
-			out += setResourceForScalar(
-				cfg, r,
-				f.Names.Camel,
-				targetAdaptedVarName,
-				sourceAdaptedVarName,
-				sourceMemberShapeRef,
-				indentLevel+1,
-			)
+			if f.IsJSON() {
+				out += setResourceForJSON(
+					f.Names.Camel,
+					targetAdaptedVarName,
+					sourceAdaptedVarName,
+					sourceMemberShapeRef,
+					errorReturn,
+					indentLevel+1,
+				)
+			} else {
+				out += setResourceForScalar(
+					cfg, r,
+					f.Names.Camel,
+					targetAdaptedVarName,
+					sourceAdaptedVarName,
+					sourceMemberShapeRef,
+					indentLevel+1,
+				)
+			}
 		}
 		out += fmt.Sprintf(
 			"%s} else {\n", indent,
 		)
 		out += fmt.Sprintf(
-			"%s%s%s.%s = nil\n", indent, indent,
+			"%s\t%s.%s = nil\n", indent,
 			targetAdaptedVarName, f.Names.Camel,
 		)
 		out += fmt.Sprintf(
@@ -385,6 +486,8 @@ func ListMemberNameInReadManyOutput(
 func setResourceReadMany(
 	cfg *ackgenconfig.Config,
 	r *model.CRD,
+	// The statement returning an error from the enclosing function
+	errorReturn string,
 	// The ReadMany operation descriptor
 	op *awssdkmodel.Operation,
 	// String representing the name of the variable that we will grab the
@@ -532,7 +635,7 @@ func setResourceReadMany(
 					indentLevel+2,
 				)
 				out += setResourceForContainer(
-					cfg, r,
+					cfg, r, errorReturn,
 					f.Names.Camel,
 					memberVarName,
 					targetMemberShapeRef,
@@ -555,7 +658,7 @@ func setResourceReadMany(
 			//                  continue
 			//              }
 			//          }
-			if util.InStrings(renamedName, matchFieldNames) {
+			if util.InStrings(renamedName, matchFieldNames) && !f.IsJSON() {
 				out += fmt.Sprintf(
 					"%s\t\tif %s.%s != nil {\n",
 					indent,
@@ -579,15 +682,26 @@ func setResourceReadMany(
 					"%s\t\t}\n", indent,
 				)
 			}
-			//          r.ko.Spec.CacheClusterID = elem.CacheClusterId
-			out += setResourceForScalar(
-				cfg, r,
-				f.Names.Camel,
-				targetAdaptedVarName,
-				sourceAdaptedVarName,
-				sourceMemberShapeRef,
-				indentLevel+2,
-			)
+			if f.IsJSON() {
+				out += setResourceForJSON(
+					f.Names.Camel,
+					targetAdaptedVarName,
+					sourceAdaptedVarName,
+					sourceMemberShapeRef,
+					errorReturn,
+					indentLevel+2,
+				)
+			} else {
+				//          r.ko.Spec.CacheClusterID = elem.CacheClusterId
+				out += setResourceForScalar(
+					cfg, r,
+					f.Names.Camel,
+					targetAdaptedVarName,
+					sourceAdaptedVarName,
+					sourceMemberShapeRef,
+					indentLevel+2,
+				)
+			}
 		}
 		out += fmt.Sprintf(
 			"%s%s} else {\n", indent, indent,
@@ -756,7 +870,32 @@ func SetResourceGetAttributes(
 		}
 
 		fieldNames := names.New(fieldName)
-		if fieldConfig.IsReadOnly {
+		if fieldConfig.IsReadOnly && r.IsJSONField(fieldName) {
+			// if resp.Attributes["Policy"] != nil {
+			//     if *resp.Attributes["Policy"] == "" {
+			//     ...
+			//     }
+			// } else {
+			//     ko.Status.Policy = nil
+			// }
+			sourceAttrVarName := fmt.Sprintf(
+				"%s.Attributes[\"%s\"]", sourceVarName, fieldName,
+			)
+			out += fmt.Sprintf("%sif %s != nil {\n", indent, sourceAttrVarName)
+			out += setResourceForJSON(
+				fieldNames.Camel,
+				adaptiveTargetVarName,
+				sourceAttrVarName,
+				nil,
+				DefaultErrorReturn,
+				indentLevel+1,
+			)
+			out += fmt.Sprintf("%s} else {\n", indent)
+			out += fmt.Sprintf(
+				"%s\t%s.%s = nil\n", indent, adaptiveTargetVarName, fieldNames.Camel,
+			)
+			out += fmt.Sprintf("%s}\n", indent)
+		} else if fieldConfig.IsReadOnly {
 			out += fmt.Sprintf(
 				"%s%s.%s = %s.Attributes[\"%s\"]\n",
 				indent,
@@ -770,6 +909,112 @@ func SetResourceGetAttributes(
 	return out
 }
 
+// SetResourceIdentifiers returns the Go code that sets the Spec or Status
+// fields that identify a resource from an AWSIdentifiers struct. It is used
+// when adopting existing AWS resources.
+//
+// A resource with a composite identifier (see the `identifier_part` field
+// config) can't be adopted, since the AWSIdentifiers struct of the ACK runtime
+// only carries a single name or ID. For example, given an API Gateway v2
+// Route with identifier parts ApiId and RouteId, the output looks like this:
+//
+//   // Route is identified by APIID and RouteID, but AWSIdentifiers only
+//   // carries a single name or ID
+//   return ackerrors.NotAdoptable
+func SetResourceIdentifiers(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// String representing the name of the variable that we will grab the
+	// identifiers from. This will likely be "identifier" since in the
+	// templates that call this method, the "source variable" is the
+	// *ackv1alpha1.AWSIdentifiers passed to SetIdentifiers
+	sourceVarName string,
+	// String representing the name of the variable that we will be
+	// **setting** with values we get from the identifiers. This will likely
+	// be "r.ko"
+	targetVarName string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+
+	if r.IsSingleton() {
+		// A singleton resource is identified by the AWS account and region
+		// of the resource manager, so there is nothing to set
+		return ""
+	}
+
+	idFields := r.IdentifierFields()
+	if len(idFields) == 0 {
+		idField := r.SpecIdentifierField()
+		if idField == nil {
+			// r.ko.Status.ACKResourceMetadata.ARN = identifier.ARN
+			out += fmt.Sprintf(
+				"%s%s%s.ACKResourceMetadata.ARN = %s.ARN\n",
+				indent, targetVarName, cfg.PrefixConfig.StatusField,
+				sourceVarName,
+			)
+			return out
+		}
+		out += setResourceIdentifierFromNameOrID(
+			sourceVarName,
+			targetVarName+cfg.PrefixConfig.SpecField+"."+*idField,
+			indentLevel,
+		)
+		return out
+	}
+
+	if len(idFields) > 1 {
+		partNames := []string{}
+		for _, f := range idFields {
+			partNames = append(partNames, f.Names.Camel)
+		}
+		out += fmt.Sprintf(
+			"%s// %s is identified by %s, but AWSIdentifiers only\n",
+			indent, r.Names.Camel, strings.Join(partNames, " and "),
+		)
+		out += fmt.Sprintf("%s// carries a single name or ID\n", indent)
+		out += fmt.Sprintf("%sreturn ackerrors.NotAdoptable\n", indent)
+		return out
+	}
+	f := idFields[0]
+	targetAdaptedVarName := targetVarName
+	if _, found := r.SpecFields[f.Names.Original]; found {
+		targetAdaptedVarName += cfg.PrefixConfig.SpecField
+	} else {
+		targetAdaptedVarName += cfg.PrefixConfig.StatusField
+	}
+	targetAdaptedVarName += "." + f.Names.Camel
+	out += setResourceIdentifierFromNameOrID(
+		sourceVarName, targetAdaptedVarName, indentLevel,
+	)
+	return out
+}
+
+// setResourceIdentifierFromNameOrID returns the Go code that sets the target
+// variable to the NameOrID field of the AWSIdentifiers source variable,
+// returning an error when NameOrID is missing.
+func setResourceIdentifierFromNameOrID(
+	sourceVarName string,
+	targetVarName string,
+	indentLevel int,
+) string {
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+	// if identifier.NameOrID == nil {
+	//     return ackerrors.MissingNameIdentifier
+	// }
+	// r.ko.Spec.Name = identifier.NameOrID
+	out += fmt.Sprintf("%sif %s.NameOrID == nil {\n", indent, sourceVarName)
+	out += fmt.Sprintf("%s\treturn ackerrors.MissingNameIdentifier\n", indent)
+	out += fmt.Sprintf("%s}\n", indent)
+	out += fmt.Sprintf(
+		"%s%s = %s.NameOrID\n", indent, targetVarName, sourceVarName,
+	)
+	return out
+}
+
 // setResourceForContainer returns a string of Go code that sets the value of a
 // target variable to that of a source variable. When the source variable type
 // is a map, struct or slice type, then this function is called recursively on
@@ -777,6 +1022,8 @@ func SetResourceGetAttributes(
 func setResourceForContainer(
 	cfg *ackgenconfig.Config,
 	r *model.CRD,
+	// The statement returning an error from the enclosing function
+	errorReturn string,
 	// The name of the CR field we're outputting for
 	targetFieldName string,
 	// The variable name that we want to set a value to
@@ -792,7 +1039,7 @@ func setResourceForContainer(
 	switch sourceShapeRef.Shape.Type {
 	case "structure":
 		return setResourceForStruct(
-			cfg, r,
+			cfg, r, errorReturn,
 			targetFieldName,
 			targetVarName,
 			targetShapeRef,
@@ -802,7 +1049,7 @@ func setResourceForContainer(
 		)
 	case "list":
 		return setResourceForSlice(
-			cfg, r,
+			cfg, r, errorReturn,
 			targetFieldName,
 			targetVarName,
 			targetShapeRef,
@@ -812,7 +1059,7 @@ func setResourceForContainer(
 		)
 	case "map":
 		return setResourceForMap(
-			cfg, r,
+			cfg, r, errorReturn,
 			targetFieldName,
 			targetVarName,
 			targetShapeRef,
@@ -837,6 +1084,8 @@ func setResourceForContainer(
 func setResourceForStruct(
 	cfg *ackgenconfig.Config,
 	r *model.CRD,
+	// The statement returning an error from the enclosing function
+	errorReturn string,
 	// The name of the CR field we're outputting for
 	targetFieldName string,
 	// The variable name that we want to set a value to
@@ -877,7 +1126,7 @@ func setResourceForStruct(
 					indentLevel+1,
 				)
 				out += setResourceForContainer(
-					cfg, r,
+					cfg, r, errorReturn,
 					cleanNames.Camel,
 					memberVarName,
 					targetMemberShapeRef,
@@ -895,14 +1144,25 @@ func setResourceForStruct(
 				)
 			}
 		default:
-			out += setResourceForScalar(
-				cfg, r,
-				cleanNames.Camel,
-				targetVarName,
-				sourceAdaptedVarName,
-				memberShapeRef,
-				indentLevel+1,
-			)
+			if memberShapeRef.JSONValue {
+				out += setResourceForJSON(
+					cleanNames.Camel,
+					targetVarName,
+					sourceAdaptedVarName,
+					memberShapeRef,
+					errorReturn,
+					indentLevel+1,
+				)
+			} else {
+				out += setResourceForScalar(
+					cfg, r,
+					cleanNames.Camel,
+					targetVarName,
+					sourceAdaptedVarName,
+					memberShapeRef,
+					indentLevel+1,
+				)
+			}
 		}
 		out += fmt.Sprintf(
 			"%s}\n", indent,
@@ -916,6 +1176,8 @@ func setResourceForStruct(
 func setResourceForSlice(
 	cfg *ackgenconfig.Config,
 	r *model.CRD,
+	// The statement returning an error from the enclosing function
+	errorReturn string,
 	// The name of the CR field we're outputting for
 	targetFieldName string,
 	// The variable name that we want to set a value to
@@ -953,7 +1215,7 @@ func setResourceForSlice(
 		containerFieldName = targetFieldName
 	}
 	out += setResourceForContainer(
-		cfg, r,
+		cfg, r, errorReturn,
 		containerFieldName,
 		elemVarName,
 		&targetShape.MemberRef,
@@ -979,6 +1241,8 @@ func setResourceForSlice(
 func setResourceForMap(
 	cfg *ackgenconfig.Config,
 	r *model.CRD,
+	// The statement returning an error from the enclosing function
+	errorReturn string,
 	// The name of the CR field we're outputting for
 	targetFieldName string,
 	// The variable name that we want to set a value to
@@ -999,6 +1263,11 @@ func setResourceForMap(
 	valIterVarName := fmt.Sprintf("%svaliter", targetVarName)
 	keyVarName := fmt.Sprintf("%skey", targetVarName)
 	valVarName := fmt.Sprintf("%sval", targetVarName)
+	if targetShape.ValueRef.Shape.Type == "list" {
+		// The iterator variable of the slice value would otherwise shadow
+		// this map's value iterator variable
+		valVarName = fmt.Sprintf("%svals", targetVarName)
+	}
 	// for f0key, f0valiter := range resp.Tags {
 	out += fmt.Sprintf("%sfor %s, %s := range %s {\n", indent, keyVarName, valIterVarName, sourceVarName)
 	//		f0elem := string{}
@@ -1014,7 +1283,7 @@ func setResourceForMap(
 		containerFieldName = targetFieldName
 	}
 	out += setResourceForContainer(
-		cfg, r,
+		cfg, r, errorReturn,
 		containerFieldName,
 		valVarName,
 		&targetShape.ValueRef,
@@ -1066,3 +1335,78 @@ func setResourceForScalar(
 	out += fmt.Sprintf("%s%s = %s\n", indent, targetVarPath, setTo)
 	return out
 }
+
+// setResourceForJSON returns a string of Go code that sets a target variable
+// value to a source variable containing a JSON document. The source variable
+// is either an `aws.JSONValue`, which is marshaled into the JSON document, or
+// a string containing the JSON document. An empty string unsets the target
+// variable, and a string that isn't a valid JSON document is returned as an
+// error, since the custom resource could not be serialized with it.
+//
+// Output code will look something like this:
+//
+//   if tmpJSON, err := json.Marshal(resp.Conditions); err != nil {
+//       return nil, err
+//   } else {
+//       ko.Spec.Conditions = &apiextensionsv1.JSON{Raw: tmpJSON}
+//   }
+//
+// or this:
+//
+//   if *resp.Policy == "" {
+//       ko.Spec.Policy = nil
+//   } else if !json.Valid([]byte(*resp.Policy)) {
+//       err := fmt.Errorf("Policy is not a valid JSON document")
+//       return nil, err
+//   } else {
+//       ko.Spec.Policy = &apiextensionsv1.JSON{Raw: []byte(*resp.Policy)}
+//   }
+func setResourceForJSON(
+	// The name of the CR field we're outputting for
+	targetFieldName string,
+	// The variable name that we want to set a value to
+	targetVarName string,
+	// The struct or struct field that we access our source value from
+	sourceVarName string,
+	// ShapeRef of the source field, which may be nil for string fields
+	// unpacked from an Attributes map
+	shapeRef *awssdkmodel.ShapeRef,
+	// The statement returning an error from the enclosing function
+	errorReturn string,
+	indentLevel int,
+) string {
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+	targetVarPath := targetVarName + "." + targetFieldName
+	if shapeRef != nil && shapeRef.JSONValue {
+		out += fmt.Sprintf(
+			"%sif tmpJSON, err := json.Marshal(%s); err != nil {\n",
+			indent, sourceVarName,
+		)
+		out += fmt.Sprintf("%s\t%s\n", indent, errorReturn)
+		out += fmt.Sprintf("%s} else {\n", indent)
+		out += fmt.Sprintf(
+			"%s\t%s = &apiextensionsv1.JSON{Raw: tmpJSON}\n",
+			indent, targetVarPath,
+		)
+		out += fmt.Sprintf("%s}\n", indent)
+		return out
+	}
+	out += fmt.Sprintf("%sif *%s == \"\" {\n", indent, sourceVarName)
+	out += fmt.Sprintf("%s\t%s = nil\n", indent, targetVarPath)
+	out += fmt.Sprintf(
+		"%s} else if !json.Valid([]byte(*%s)) {\n", indent, sourceVarName,
+	)
+	out += fmt.Sprintf(
+		"%s\terr := fmt.Errorf(\"%s is not a valid JSON document\")\n",
+		indent, targetFieldName,
+	)
+	out += fmt.Sprintf("%s\t%s\n", indent, errorReturn)
+	out += fmt.Sprintf("%s} else {\n", indent)
+	out += fmt.Sprintf(
+		"%s\t%s = &apiextensionsv1.JSON{Raw: []byte(*%s)}\n",
+		indent, targetVarPath, sourceVarName,
+	)
+	out += fmt.Sprintf("%s}\n", indent)
+	return out
+}
@@ -155,6 +155,25 @@ func TestSetResource_APIGWv2_Route_ReadOne(t *testing.T) {
 	)
 }
 
+func TestSetResourceIdentifiers_APIGWv2_Route(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewGeneratorForService(t, "apigatewayv2")
+
+	crd := testutil.GetCRDByName(t, g, "Route")
+	require.NotNil(crd)
+
+	expected := `	// Route is identified by APIID and RouteID, but AWSIdentifiers only
+	// carries a single name or ID
+	return ackerrors.NotAdoptable
+`
+	assert.Equal(
+		expected,
+		code.SetResourceIdentifiers(crd.Config(), crd, "identifier", "r.ko", 1),
+	)
+}
+
 func TestSetResource_DynamoDB_Backup_ReadOne(t *testing.T) {
 	assert := assert.New(t)
 	require := require.New(t)
@@ -235,6 +254,197 @@ func TestSetResource_CodeDeploy_Deployment_Create(t *testing.T) {
 	)
 }
 
+func TestSetResource_CodeDeploy_Deployment_ReadOne(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewGeneratorForService(t, "codedeploy")
+
+	crd := testutil.GetCRDByName(t, g, "Deployment")
+	require.NotNil(crd)
+
+	// TargetInstances.EC2TagSet.EC2TagSetList is a list of lists of structs
+	expected := `
+	if resp.DeploymentInfo.ApplicationName != nil {
+		ko.Spec.ApplicationName = resp.DeploymentInfo.ApplicationName
+	} else {
+		ko.Spec.ApplicationName = nil
+	}
+	if resp.DeploymentInfo.AutoRollbackConfiguration != nil {
+		f1 := &svcapitypes.AutoRollbackConfiguration{}
+		if resp.DeploymentInfo.AutoRollbackConfiguration.Enabled != nil {
+			f1.Enabled = resp.DeploymentInfo.AutoRollbackConfiguration.Enabled
+		}
+		if resp.DeploymentInfo.AutoRollbackConfiguration.Events != nil {
+			f1f1 := []*string{}
+			for _, f1f1iter := range resp.DeploymentInfo.AutoRollbackConfiguration.Events {
+				var f1f1elem string
+				f1f1elem = *f1f1iter
+				f1f1 = append(f1f1, &f1f1elem)
+			}
+			f1.Events = f1f1
+		}
+		ko.Spec.AutoRollbackConfiguration = f1
+	} else {
+		ko.Spec.AutoRollbackConfiguration = nil
+	}
+	if resp.DeploymentInfo.DeploymentConfigName != nil {
+		ko.Spec.DeploymentConfigName = resp.DeploymentInfo.DeploymentConfigName
+	} else {
+		ko.Spec.DeploymentConfigName = nil
+	}
+	if resp.DeploymentInfo.DeploymentGroupName != nil {
+		ko.Spec.DeploymentGroupName = resp.DeploymentInfo.DeploymentGroupName
+	} else {
+		ko.Spec.DeploymentGroupName = nil
+	}
+	if resp.DeploymentInfo.DeploymentId != nil {
+		ko.Status.DeploymentID = resp.DeploymentInfo.DeploymentId
+	} else {
+		ko.Status.DeploymentID = nil
+	}
+	if resp.DeploymentInfo.Description != nil {
+		ko.Spec.Description = resp.DeploymentInfo.Description
+	} else {
+		ko.Spec.Description = nil
+	}
+	if resp.DeploymentInfo.FileExistsBehavior != nil {
+		ko.Spec.FileExistsBehavior = resp.DeploymentInfo.FileExistsBehavior
+	} else {
+		ko.Spec.FileExistsBehavior = nil
+	}
+	if resp.DeploymentInfo.IgnoreApplicationStopFailures != nil {
+		ko.Spec.IgnoreApplicationStopFailures = resp.DeploymentInfo.IgnoreApplicationStopFailures
+	} else {
+		ko.Spec.IgnoreApplicationStopFailures = nil
+	}
+	if resp.DeploymentInfo.Revision != nil {
+		f20 := &svcapitypes.RevisionLocation{}
+		if resp.DeploymentInfo.Revision.AppSpecContent != nil {
+			f20f0 := &svcapitypes.AppSpecContent{}
+			if resp.DeploymentInfo.Revision.AppSpecContent.Content != nil {
+				f20f0.Content = resp.DeploymentInfo.Revision.AppSpecContent.Content
+			}
+			if resp.DeploymentInfo.Revision.AppSpecContent.Sha256 != nil {
+				f20f0.SHA256 = resp.DeploymentInfo.Revision.AppSpecContent.Sha256
+			}
+			f20.AppSpecContent = f20f0
+		}
+		if resp.DeploymentInfo.Revision.GitHubLocation != nil {
+			f20f1 := &svcapitypes.GitHubLocation{}
+			if resp.DeploymentInfo.Revision.GitHubLocation.CommitId != nil {
+				f20f1.CommitID = resp.DeploymentInfo.Revision.GitHubLocation.CommitId
+			}
+			if resp.DeploymentInfo.Revision.GitHubLocation.Repository != nil {
+				f20f1.Repository = resp.DeploymentInfo.Revision.GitHubLocation.Repository
+			}
+			f20.GitHubLocation = f20f1
+		}
+		if resp.DeploymentInfo.Revision.RevisionType != nil {
+			f20.RevisionType = resp.DeploymentInfo.Revision.RevisionType
+		}
+		if resp.DeploymentInfo.Revision.S3Location != nil {
+			f20f3 := &svcapitypes.S3Location{}
+			if resp.DeploymentInfo.Revision.S3Location.Bucket != nil {
+				f20f3.Bucket = resp.DeploymentInfo.Revision.S3Location.Bucket
+			}
+			if resp.DeploymentInfo.Revision.S3Location.BundleType != nil {
+				f20f3.BundleType = resp.DeploymentInfo.Revision.S3Location.BundleType
+			}
+			if resp.DeploymentInfo.Revision.S3Location.ETag != nil {
+				f20f3.ETag = resp.DeploymentInfo.Revision.S3Location.ETag
+			}
+			if resp.DeploymentInfo.Revision.S3Location.Key != nil {
+				f20f3.Key = resp.DeploymentInfo.Revision.S3Location.Key
+			}
+			if resp.DeploymentInfo.Revision.S3Location.Version != nil {
+				f20f3.Version = resp.DeploymentInfo.Revision.S3Location.Version
+			}
+			f20.S3Location = f20f3
+		}
+		if resp.DeploymentInfo.Revision.String_ != nil {
+			f20f4 := &svcapitypes.RawString{}
+			if resp.DeploymentInfo.Revision.String_.Content != nil {
+				f20f4.Content = resp.DeploymentInfo.Revision.String_.Content
+			}
+			if resp.DeploymentInfo.Revision.String_.Sha256 != nil {
+				f20f4.SHA256 = resp.DeploymentInfo.Revision.String_.Sha256
+			}
+			f20.String = f20f4
+		}
+		ko.Spec.Revision = f20
+	} else {
+		ko.Spec.Revision = nil
+	}
+	if resp.DeploymentInfo.TargetInstances != nil {
+		f24 := &svcapitypes.TargetInstances{}
+		if resp.DeploymentInfo.TargetInstances.AutoScalingGroups != nil {
+			f24f0 := []*string{}
+			for _, f24f0iter := range resp.DeploymentInfo.TargetInstances.AutoScalingGroups {
+				var f24f0elem string
+				f24f0elem = *f24f0iter
+				f24f0 = append(f24f0, &f24f0elem)
+			}
+			f24.AutoScalingGroups = f24f0
+		}
+		if resp.DeploymentInfo.TargetInstances.Ec2TagSet != nil {
+			f24f1 := &svcapitypes.EC2TagSet{}
+			if resp.DeploymentInfo.TargetInstances.Ec2TagSet.Ec2TagSetList != nil {
+				f24f1f0 := [][]*svcapitypes.EC2TagFilter{}
+				for _, f24f1f0iter := range resp.DeploymentInfo.TargetInstances.Ec2TagSet.Ec2TagSetList {
+					f24f1f0elem := []*svcapitypes.EC2TagFilter{}
+					for _, f24f1f0elemiter := range f24f1f0iter {
+						f24f1f0elemelem := &svcapitypes.EC2TagFilter{}
+						if f24f1f0elemiter.Key != nil {
+							f24f1f0elemelem.Key = f24f1f0elemiter.Key
+						}
+						if f24f1f0elemiter.Type != nil {
+							f24f1f0elemelem.Type = f24f1f0elemiter.Type
+						}
+						if f24f1f0elemiter.Value != nil {
+							f24f1f0elemelem.Value = f24f1f0elemiter.Value
+						}
+						f24f1f0elem = append(f24f1f0elem, f24f1f0elemelem)
+					}
+					f24f1f0 = append(f24f1f0, f24f1f0elem)
+				}
+				f24f1.EC2TagSetList = f24f1f0
+			}
+			f24.EC2TagSet = f24f1
+		}
+		if resp.DeploymentInfo.TargetInstances.TagFilters != nil {
+			f24f2 := []*svcapitypes.EC2TagFilter{}
+			for _, f24f2iter := range resp.DeploymentInfo.TargetInstances.TagFilters {
+				f24f2elem := &svcapitypes.EC2TagFilter{}
+				if f24f2iter.Key != nil {
+					f24f2elem.Key = f24f2iter.Key
+				}
+				if f24f2iter.Type != nil {
+					f24f2elem.Type = f24f2iter.Type
+				}
+				if f24f2iter.Value != nil {
+					f24f2elem.Value = f24f2iter.Value
+				}
+				f24f2 = append(f24f2, f24f2elem)
+			}
+			f24.TagFilters = f24f2
+		}
+		ko.Spec.TargetInstances = f24
+	} else {
+		ko.Spec.TargetInstances = nil
+	}
+	if resp.DeploymentInfo.UpdateOutdatedInstancesOnly != nil {
+		ko.Spec.UpdateOutdatedInstancesOnly = resp.DeploymentInfo.UpdateOutdatedInstancesOnly
+	} else {
+		ko.Spec.UpdateOutdatedInstancesOnly = nil
+	}
+`
+	assert.Equal(
+		expected,
+		code.SetResource(crd.Config(), crd, model.OpTypeGet, "resp", "ko", 1, true),
+	)
+}
+
 func TestSetResource_DynamoDB_Table_ReadOne(t *testing.T) {
 	assert := assert.New(t)
 	require := require.New(t)
@@ -2353,6 +2563,43 @@ func TestSetResource_S3_Bucket_Create(t *testing.T) {
 	)
 }
 
+func TestSetResourceIdentifiers_S3_Bucket(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewGeneratorForService(t, "s3")
+
+	crd := testutil.GetCRDByName(t, g, "Bucket")
+	require.NotNil(crd)
+
+	expected := `	if identifier.NameOrID == nil {
+		return ackerrors.MissingNameIdentifier
+	}
+	r.ko.Spec.Name = identifier.NameOrID
+`
+	assert.Equal(
+		expected,
+		code.SetResourceIdentifiers(crd.Config(), crd, "identifier", "r.ko", 1),
+	)
+}
+
+func TestSetResourceIdentifiers_S3Control_PublicAccessBlock(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewGeneratorForService(t, "s3control")
+
+	crd := testutil.GetCRDByName(t, g, "PublicAccessBlock")
+	require.NotNil(crd)
+
+	// A singleton resource is identified by the AWS account and region of the
+	// resource manager
+	assert.Equal(
+		"",
+		code.SetResourceIdentifiers(crd.Config(), crd, "identifier", "r.ko", 1),
+	)
+}
+
 func TestSetResource_S3_Bucket_ReadMany(t *testing.T) {
 	assert := assert.New(t)
 	require := require.New(t)
@@ -2431,9 +2678,22 @@ func TestSetResource_SNS_Topic_GetAttributes(t *testing.T) {
 	// "Attributes" that must be unpacked into the Topic CRD's Status fields.
 	// There are only three attribute keys that are *not* in the Input shape
 	// (and thus in the Spec fields). Two of them are the tesource's ARN and
-	// AWS Owner account ID, both of which are handled specially.
+	// AWS Owner account ID, both of which are handled specially. The other,
+	// EffectiveDeliveryPolicy, is configured as a JSON document, which is
+	// unset when empty and must be valid JSON.
 	expected := `
-	ko.Status.EffectiveDeliveryPolicy = resp.Attributes["EffectiveDeliveryPolicy"]
+	if resp.Attributes["EffectiveDeliveryPolicy"] != nil {
+		if *resp.Attributes["EffectiveDeliveryPolicy"] == "" {
+			ko.Status.EffectiveDeliveryPolicy = nil
+		} else if !json.Valid([]byte(*resp.Attributes["EffectiveDeliveryPolicy"])) {
+			err := fmt.Errorf("EffectiveDeliveryPolicy is not a valid JSON document")
+			return nil, err
+		} else {
+			ko.Status.EffectiveDeliveryPolicy = &apiextensionsv1.JSON{Raw: []byte(*resp.Attributes["EffectiveDeliveryPolicy"])}
+		}
+	} else {
+		ko.Status.EffectiveDeliveryPolicy = nil
+	}
 	if ko.Status.ACKResourceMetadata == nil {
 		ko.Status.ACKResourceMetadata = &ackv1alpha1.ResourceMetadata{}
 	}
@@ -2587,6 +2847,134 @@ func TestSetResource_RDS_DBSubnetGroup_ReadMany(t *testing.T) {
 	)
 }
 
+func TestSetResource_SageMaker_FlowDefinition_ReadOne(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewGeneratorForService(t, "sagemaker")
+
+	crd := testutil.GetCRDByName(t, g, "FlowDefinition")
+	require.NotNil(crd)
+
+	// The HumanLoopActivationConditions member of the
+	// HumanLoopActivationConditionsConfig shape is an aws.JSONValue, which is
+	// marshaled into a structured JSON document
+	expected := `
+	if ko.Status.ACKResourceMetadata == nil {
+		ko.Status.ACKResourceMetadata = &ackv1alpha1.ResourceMetadata{}
+	}
+	if resp.FlowDefinitionArn != nil {
+		arn := ackv1alpha1.AWSResourceName(*resp.FlowDefinitionArn)
+		ko.Status.ACKResourceMetadata.ARN = &arn
+	}
+	if resp.FlowDefinitionName != nil {
+		ko.Spec.FlowDefinitionName = resp.FlowDefinitionName
+	} else {
+		ko.Spec.FlowDefinitionName = nil
+	}
+	if resp.HumanLoopActivationConfig != nil {
+		f5 := &svcapitypes.HumanLoopActivationConfig{}
+		if resp.HumanLoopActivationConfig.HumanLoopActivationConditionsConfig != nil {
+			f5f0 := &svcapitypes.HumanLoopActivationConditionsConfig{}
+			if resp.HumanLoopActivationConfig.HumanLoopActivationConditionsConfig.HumanLoopActivationConditions != nil {
+				if tmpJSON, err := json.Marshal(resp.HumanLoopActivationConfig.HumanLoopActivationConditionsConfig.HumanLoopActivationConditions); err != nil {
+					return nil, err
+				} else {
+					f5f0.HumanLoopActivationConditions = &apiextensionsv1.JSON{Raw: tmpJSON}
+				}
+			}
+			f5.HumanLoopActivationConditionsConfig = f5f0
+		}
+		ko.Spec.HumanLoopActivationConfig = f5
+	} else {
+		ko.Spec.HumanLoopActivationConfig = nil
+	}
+	if resp.HumanLoopConfig != nil {
+		f6 := &svcapitypes.HumanLoopConfig{}
+		if resp.HumanLoopConfig.HumanTaskUiArn != nil {
+			f6.HumanTaskUiARN = resp.HumanLoopConfig.HumanTaskUiArn
+		}
+		if resp.HumanLoopConfig.PublicWorkforceTaskPrice != nil {
+			f6f1 := &svcapitypes.PublicWorkforceTaskPrice{}
+			if resp.HumanLoopConfig.PublicWorkforceTaskPrice.AmountInUsd != nil {
+				f6f1f0 := &svcapitypes.USD{}
+				if resp.HumanLoopConfig.PublicWorkforceTaskPrice.AmountInUsd.Cents != nil {
+					f6f1f0.Cents = resp.HumanLoopConfig.PublicWorkforceTaskPrice.AmountInUsd.Cents
+				}
+				if resp.HumanLoopConfig.PublicWorkforceTaskPrice.AmountInUsd.Dollars != nil {
+					f6f1f0.Dollars = resp.HumanLoopConfig.PublicWorkforceTaskPrice.AmountInUsd.Dollars
+				}
+				if resp.HumanLoopConfig.PublicWorkforceTaskPrice.AmountInUsd.TenthFractionsOfACent != nil {
+					f6f1f0.TenthFractionsOfACent = resp.HumanLoopConfig.PublicWorkforceTaskPrice.AmountInUsd.TenthFractionsOfACent
+				}
+				f6f1.AmountInUsd = f6f1f0
+			}
+			f6.PublicWorkforceTaskPrice = f6f1
+		}
+		if resp.HumanLoopConfig.TaskAvailabilityLifetimeInSeconds != nil {
+			f6.TaskAvailabilityLifetimeInSeconds = resp.HumanLoopConfig.TaskAvailabilityLifetimeInSeconds
+		}
+		if resp.HumanLoopConfig.TaskCount != nil {
+			f6.TaskCount = resp.HumanLoopConfig.TaskCount
+		}
+		if resp.HumanLoopConfig.TaskDescription != nil {
+			f6.TaskDescription = resp.HumanLoopConfig.TaskDescription
+		}
+		if resp.HumanLoopConfig.TaskKeywords != nil {
+			f6f5 := []*string{}
+			for _, f6f5iter := range resp.HumanLoopConfig.TaskKeywords {
+				var f6f5elem string
+				f6f5elem = *f6f5iter
+				f6f5 = append(f6f5, &f6f5elem)
+			}
+			f6.TaskKeywords = f6f5
+		}
+		if resp.HumanLoopConfig.TaskTimeLimitInSeconds != nil {
+			f6.TaskTimeLimitInSeconds = resp.HumanLoopConfig.TaskTimeLimitInSeconds
+		}
+		if resp.HumanLoopConfig.TaskTitle != nil {
+			f6.TaskTitle = resp.HumanLoopConfig.TaskTitle
+		}
+		if resp.HumanLoopConfig.WorkteamArn != nil {
+			f6.WorkteamARN = resp.HumanLoopConfig.WorkteamArn
+		}
+		ko.Spec.HumanLoopConfig = f6
+	} else {
+		ko.Spec.HumanLoopConfig = nil
+	}
+	if resp.HumanLoopRequestSource != nil {
+		f7 := &svcapitypes.HumanLoopRequestSource{}
+		if resp.HumanLoopRequestSource.AwsManagedHumanLoopRequestSource != nil {
+			f7.AWSManagedHumanLoopRequestSource = resp.HumanLoopRequestSource.AwsManagedHumanLoopRequestSource
+		}
+		ko.Spec.HumanLoopRequestSource = f7
+	} else {
+		ko.Spec.HumanLoopRequestSource = nil
+	}
+	if resp.OutputConfig != nil {
+		f8 := &svcapitypes.FlowDefinitionOutputConfig{}
+		if resp.OutputConfig.KmsKeyId != nil {
+			f8.KMSKeyID = resp.OutputConfig.KmsKeyId
+		}
+		if resp.OutputConfig.S3OutputPath != nil {
+			f8.S3OutputPath = resp.OutputConfig.S3OutputPath
+		}
+		ko.Spec.OutputConfig = f8
+	} else {
+		ko.Spec.OutputConfig = nil
+	}
+	if resp.RoleArn != nil {
+		ko.Spec.RoleARN = resp.RoleArn
+	} else {
+		ko.Spec.RoleARN = nil
+	}
+`
+	assert.Equal(
+		expected,
+		code.SetResource(crd.Config(), crd, model.OpTypeGet, "resp", "ko", 1, true),
+	)
+}
+
 func TestGetWrapperOutputShape(t *testing.T) {
 	assert := assert.New(t)
 	require := require.New(t)
@@ -2645,3 +3033,135 @@ func TestGetWrapperOutputShape(t *testing.T) {
 		})
 	}
 }
+
+func TestSetResource_CloudFront_CachePolicy_ReadOne(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewGeneratorForService(t, "cloudfront")
+
+	crd := testutil.GetCRDByName(t, g, "CachePolicy")
+	require.NotNil(crd)
+
+	// The members of the CachePolicyConfig wrapper are set into the
+	// top-level Spec fields
+	expected := `
+	if resp.CachePolicy.CachePolicyConfig != nil {
+		if resp.CachePolicy.CachePolicyConfig.Comment != nil {
+			ko.Spec.Comment = resp.CachePolicy.CachePolicyConfig.Comment
+		} else {
+			ko.Spec.Comment = nil
+		}
+		if resp.CachePolicy.CachePolicyConfig.DefaultTTL != nil {
+			ko.Spec.DefaultTTL = resp.CachePolicy.CachePolicyConfig.DefaultTTL
+		} else {
+			ko.Spec.DefaultTTL = nil
+		}
+		if resp.CachePolicy.CachePolicyConfig.MaxTTL != nil {
+			ko.Spec.MaxTTL = resp.CachePolicy.CachePolicyConfig.MaxTTL
+		} else {
+			ko.Spec.MaxTTL = nil
+		}
+		if resp.CachePolicy.CachePolicyConfig.MinTTL != nil {
+			ko.Spec.MinTTL = resp.CachePolicy.CachePolicyConfig.MinTTL
+		} else {
+			ko.Spec.MinTTL = nil
+		}
+		if resp.CachePolicy.CachePolicyConfig.Name != nil {
+			ko.Spec.Name = resp.CachePolicy.CachePolicyConfig.Name
+		} else {
+			ko.Spec.Name = nil
+		}
+		if resp.CachePolicy.CachePolicyConfig.ParametersInCacheKeyAndForwardedToOrigin != nil {
+			f0f5 := &svcapitypes.ParametersInCacheKeyAndForwardedToOrigin{}
+			if resp.CachePolicy.CachePolicyConfig.ParametersInCacheKeyAndForwardedToOrigin.CookiesConfig != nil {
+				f0f5f0 := &svcapitypes.CachePolicyCookiesConfig{}
+				if resp.CachePolicy.CachePolicyConfig.ParametersInCacheKeyAndForwardedToOrigin.CookiesConfig.CookieBehavior != nil {
+					f0f5f0.CookieBehavior = resp.CachePolicy.CachePolicyConfig.ParametersInCacheKeyAndForwardedToOrigin.CookiesConfig.CookieBehavior
+				}
+				if resp.CachePolicy.CachePolicyConfig.ParametersInCacheKeyAndForwardedToOrigin.CookiesConfig.Cookies != nil {
+					f0f5f0f1 := &svcapitypes.CookieNames{}
+					if resp.CachePolicy.CachePolicyConfig.ParametersInCacheKeyAndForwardedToOrigin.CookiesConfig.Cookies.Items != nil {
+						f0f5f0f1f0 := []*string{}
+						for _, f0f5f0f1f0iter := range resp.CachePolicy.CachePolicyConfig.ParametersInCacheKeyAndForwardedToOrigin.CookiesConfig.Cookies.Items {
+							var f0f5f0f1f0elem string
+							f0f5f0f1f0elem = *f0f5f0f1f0iter
+							f0f5f0f1f0 = append(f0f5f0f1f0, &f0f5f0f1f0elem)
+						}
+						f0f5f0f1.Items = f0f5f0f1f0
+					}
+					if resp.CachePolicy.CachePolicyConfig.ParametersInCacheKeyAndForwardedToOrigin.CookiesConfig.Cookies.Quantity != nil {
+						f0f5f0f1.Quantity = resp.CachePolicy.CachePolicyConfig.ParametersInCacheKeyAndForwardedToOrigin.CookiesConfig.Cookies.Quantity
+					}
+					f0f5f0.Cookies = f0f5f0f1
+				}
+				f0f5.CookiesConfig = f0f5f0
+			}
+			if resp.CachePolicy.CachePolicyConfig.ParametersInCacheKeyAndForwardedToOrigin.EnableAcceptEncodingBrotli != nil {
+				f0f5.EnableAcceptEncodingBrotli = resp.CachePolicy.CachePolicyConfig.ParametersInCacheKeyAndForwardedToOrigin.EnableAcceptEncodingBrotli
+			}
+			if resp.CachePolicy.CachePolicyConfig.ParametersInCacheKeyAndForwardedToOrigin.EnableAcceptEncodingGzip != nil {
+				f0f5.EnableAcceptEncodingGzip = resp.CachePolicy.CachePolicyConfig.ParametersInCacheKeyAndForwardedToOrigin.EnableAcceptEncodingGzip
+			}
+			if resp.CachePolicy.CachePolicyConfig.ParametersInCacheKeyAndForwardedToOrigin.HeadersConfig != nil {
+				f0f5f3 := &svcapitypes.CachePolicyHeadersConfig{}
+				if resp.CachePolicy.CachePolicyConfig.ParametersInCacheKeyAndForwardedToOrigin.HeadersConfig.HeaderBehavior != nil {
+					f0f5f3.HeaderBehavior = resp.CachePolicy.CachePolicyConfig.ParametersInCacheKeyAndForwardedToOrigin.HeadersConfig.HeaderBehavior
+				}
+				if resp.CachePolicy.CachePolicyConfig.ParametersInCacheKeyAndForwardedToOrigin.HeadersConfig.Headers != nil {
+					f0f5f3f1 := &svcapitypes.Headers{}
+					if resp.CachePolicy.CachePolicyConfig.ParametersInCacheKeyAndForwardedToOrigin.HeadersConfig.Headers.Items != nil {
+						f0f5f3f1f0 := []*string{}
+						for _, f0f5f3f1f0iter := range resp.CachePolicy.CachePolicyConfig.ParametersInCacheKeyAndForwardedToOrigin.HeadersConfig.Headers.Items {
+							var f0f5f3f1f0elem string
+							f0f5f3f1f0elem = *f0f5f3f1f0iter
+							f0f5f3f1f0 = append(f0f5f3f1f0, &f0f5f3f1f0elem)
+						}
+						f0f5f3f1.Items = f0f5f3f1f0
+					}
+					if resp.CachePolicy.CachePolicyConfig.ParametersInCacheKeyAndForwardedToOrigin.HeadersConfig.Headers.Quantity != nil {
+						f0f5f3f1.Quantity = resp.CachePolicy.CachePolicyConfig.ParametersInCacheKeyAndForwardedToOrigin.HeadersConfig.Headers.Quantity
+					}
+					f0f5f3.Headers = f0f5f3f1
+				}
+				f0f5.HeadersConfig = f0f5f3
+			}
+			if resp.CachePolicy.CachePolicyConfig.ParametersInCacheKeyAndForwardedToOrigin.QueryStringsConfig != nil {
+				f0f5f4 := &svcapitypes.CachePolicyQueryStringsConfig{}
+				if resp.CachePolicy.CachePolicyConfig.ParametersInCacheKeyAndForwardedToOrigin.QueryStringsConfig.QueryStringBehavior != nil {
+					f0f5f4.QueryStringBehavior = resp.CachePolicy.CachePolicyConfig.ParametersInCacheKeyAndForwardedToOrigin.QueryStringsConfig.QueryStringBehavior
+				}
+				if resp.CachePolicy.CachePolicyConfig.ParametersInCacheKeyAndForwardedToOrigin.QueryStringsConfig.QueryStrings != nil {
+					f0f5f4f1 := &svcapitypes.QueryStringNames{}
+					if resp.CachePolicy.CachePolicyConfig.ParametersInCacheKeyAndForwardedToOrigin.QueryStringsConfig.QueryStrings.Items != nil {
+						f0f5f4f1f0 := []*string{}
+						for _, f0f5f4f1f0iter := range resp.CachePolicy.CachePolicyConfig.ParametersInCacheKeyAndForwardedToOrigin.QueryStringsConfig.QueryStrings.Items {
+							var f0f5f4f1f0elem string
+							f0f5f4f1f0elem = *f0f5f4f1f0iter
+							f0f5f4f1f0 = append(f0f5f4f1f0, &f0f5f4f1f0elem)
+						}
+						f0f5f4f1.Items = f0f5f4f1f0
+					}
+					if resp.CachePolicy.CachePolicyConfig.ParametersInCacheKeyAndForwardedToOrigin.QueryStringsConfig.QueryStrings.Quantity != nil {
+						f0f5f4f1.Quantity = resp.CachePolicy.CachePolicyConfig.ParametersInCacheKeyAndForwardedToOrigin.QueryStringsConfig.QueryStrings.Quantity
+					}
+					f0f5f4.QueryStrings = f0f5f4f1
+				}
+				f0f5.QueryStringsConfig = f0f5f4
+			}
+			ko.Spec.ParametersInCacheKeyAndForwardedToOrigin = f0f5
+		} else {
+			ko.Spec.ParametersInCacheKeyAndForwardedToOrigin = nil
+		}
+	}
+	if resp.CachePolicy.Id != nil {
+		ko.Status.ID = resp.CachePolicy.Id
+	} else {
+		ko.Status.ID = nil
+	}
+`
+	assert.Equal(
+		expected,
+		code.SetResource(crd.Config(), crd, model.OpTypeGet, "resp", "ko", 1, true),
+	)
+}
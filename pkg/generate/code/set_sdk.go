@@ -105,6 +105,50 @@ func SetSDK(
 	default:
 		return ""
 	}
+	return setSDKForOperation(
+		cfg, r, defaultSecretResolution, DefaultAccountID, op, sourceVarName,
+		targetVarName, indentLevel,
+	)
+}
+
+// SetSDKAction returns the Go code that sets the Input shape's member fields
+// of the API operation that transitions a resource into a runtime state from
+// a CRD's fields. See SetSDK.
+func SetSDKAction(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// The runtime state of the resource to output the Input shape for
+	action *model.Action,
+	// String representing the name of the variable that we will grab the Input
+	// shape from. This will likely be "r.ko"
+	sourceVarName string,
+	// String representing the name of the variable that we will be **setting**
+	// with values we get from the CRD. This will likely be "res"
+	targetVarName string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	return setSDKForOperation(
+		cfg, r, defaultSecretResolution, DefaultAccountID, action.Op,
+		sourceVarName, targetVarName, indentLevel,
+	)
+}
+
+// setSDKForOperation returns the Go code that sets the Input shape's member
+// fields of the supplied operation from a CRD's fields
+func setSDKForOperation(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// How the values of SecretKeyReference fields are resolved
+	secrets secretResolution,
+	// Expression evaluating to the AWS account ID that the AccountId member
+	// of a singleton resource's Input shape is set to
+	accountID string,
+	op *awssdkmodel.Operation,
+	sourceVarName string,
+	targetVarName string,
+	indentLevel int,
+) string {
 	if op == nil {
 		return ""
 	}
@@ -154,7 +198,7 @@ func SetSDK(
 				)
 				out += fmt.Sprintf(
 					"%s\tattrMap[\"%s\"] = %s\n",
-					indent, fieldName, sourceAdaptedVarName,
+					indent, fieldName, attrMapValue(r, fieldName, sourceAdaptedVarName),
 				)
 				out += fmt.Sprintf(
 					"%s}\n", indent,
@@ -164,12 +208,75 @@ func SetSDK(
 		out += fmt.Sprintf("%s%s.SetAttributes(attrMap)\n", indent, targetVarName)
 	}
 
+	out += setSDKForShapeMembers(
+		cfg, r, secrets, accountID, op, inputShape, sourceVarName, targetVarName,
+		"", indentLevel,
+	)
+	return out
+}
+
+// setSDKForShapeMembers returns the Go code that sets the member fields of the
+// supplied shape from a CRD's fields. The shape is either the Input shape of
+// the supplied operation or, when the CRD unwraps its Input shape, the
+// wrapper member whose members are top-level Spec fields. varPrefix is
+// prepended to the names of temporary variables, which keeps them unique
+// within the wrapper.
+func setSDKForShapeMembers(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// How the values of SecretKeyReference fields are resolved
+	secrets secretResolution,
+	// Expression evaluating to the AWS account ID that the AccountId member
+	// of a singleton resource's Input shape is set to
+	accountID string,
+	op *awssdkmodel.Operation,
+	inputShape *awssdkmodel.Shape,
+	sourceVarName string,
+	targetVarName string,
+	varPrefix string,
+	indentLevel int,
+) string {
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+
 	opConfig, override := cfg.OverrideValues(op.Name)
 	for memberIndex, memberName := range inputShape.MemberNames() {
 		if r.UnpacksAttributesMap() && memberName == "Attributes" {
 			continue
 		}
 
+		if varPrefix == "" && r.IsUnwrappedInputMember(
+			memberName, inputShape.MemberRefs[memberName],
+		) {
+			// The wrapper's members are top-level Spec fields, so we
+			// re-nest them into the wrapper struct, e.g.:
+			//
+			// f0 := &svcsdk.CachePolicyConfig{}
+			// if ko.Spec.Comment != nil {
+			//     f0.SetComment(*ko.Spec.Comment)
+			// }
+			// res.SetCachePolicyConfig(f0)
+			wrapperVarName := fmt.Sprintf("f%d", memberIndex)
+			out += varEmptyConstructorSDKType(
+				cfg, r,
+				wrapperVarName,
+				inputShape.MemberRefs[memberName].Shape,
+				indentLevel,
+			)
+			out += setSDKForShapeMembers(
+				cfg, r, secrets, accountID, op,
+				inputShape.MemberRefs[memberName].Shape,
+				sourceVarName,
+				wrapperVarName,
+				wrapperVarName,
+				indentLevel,
+			)
+			out += fmt.Sprintf(
+				"%s%s.Set%s(%s)\n", indent, targetVarName, memberName, wrapperVarName,
+			)
+			continue
+		}
+
 		if override {
 			value, ok := opConfig[memberName]
 			memberShapeRef, _ := inputShape.MemberRefs[memberName]
@@ -216,6 +323,14 @@ func SetSDK(
 			)
 			continue
 		}
+		if r.IsSingletonAccountIDField(memberName) {
+			// res.SetAccountId(string(rm.awsAccountID))
+			out += fmt.Sprintf(
+				"%s%s.Set%s(%s)\n",
+				indent, targetVarName, memberName, accountID,
+			)
+			continue
+		}
 		renamedName, _ := r.InputFieldRename(op.Name, memberName)
 		// Determine whether the input shape's field is in the Spec or the
 		// Status struct and set the source variable appropriately.
@@ -300,7 +415,7 @@ func SetSDK(
 		switch memberShape.Type {
 		case "list", "structure", "map":
 			{
-				memberVarName := fmt.Sprintf("f%d", memberIndex)
+				memberVarName := fmt.Sprintf("%sf%d", varPrefix, memberIndex)
 				out += varEmptyConstructorSDKType(
 					cfg, r,
 					memberVarName,
@@ -308,7 +423,7 @@ func SetSDK(
 					indentLevel+1,
 				)
 				out += setSDKForContainer(
-					cfg, r,
+					cfg, r, secrets,
 					memberName,
 					memberVarName,
 					sourceFieldPath,
@@ -330,12 +445,21 @@ func SetSDK(
 		default:
 			if r.IsSecretField(memberName) {
 				out += setSDKForSecret(
-					cfg, r,
+					cfg, r, secrets,
 					memberName,
 					targetVarName,
 					sourceAdaptedVarName,
 					indentLevel,
 				)
+			} else if f.IsJSON() {
+				out += setSDKForJSON(
+					memberName,
+					targetVarName,
+					sourceAdaptedVarName,
+					memberShapeRef,
+					secrets.errorReturn,
+					indentLevel+1,
+				)
 			} else {
 				out += setSDKForScalar(
 					cfg, r,
@@ -506,16 +630,27 @@ func SetSDKGetAttributes(
 			"%sif %s != nil {\n",
 			indent, sourceVarPath,
 		)
-		out += setSDKForScalar(
-			cfg, r,
-			memberName,
-			targetVarName,
-			inputShape.Type,
-			cleanMemberName,
-			sourceVarPath,
-			field.ShapeRef,
-			indentLevel+1,
-		)
+		if field.IsJSON() {
+			out += setSDKForJSON(
+				memberName,
+				targetVarName,
+				sourceVarPath,
+				inputShape.MemberRefs[memberName],
+				DefaultErrorReturn,
+				indentLevel+1,
+			)
+		} else {
+			out += setSDKForScalar(
+				cfg, r,
+				memberName,
+				targetVarName,
+				inputShape.Type,
+				cleanMemberName,
+				sourceVarPath,
+				field.ShapeRef,
+				indentLevel+1,
+			)
+		}
 		out += fmt.Sprintf(
 			"%s}\n", indent,
 		)
@@ -668,7 +803,7 @@ func SetSDKSetAttributes(
 					)
 					out += fmt.Sprintf(
 						"%s\tattrMap[\"%s\"] = %s\n",
-						indent, fieldName, sourceAdaptedVarName,
+						indent, fieldName, attrMapValue(r, fieldName, sourceAdaptedVarName),
 					)
 					out += fmt.Sprintf(
 						"%s}\n", indent,
@@ -701,16 +836,27 @@ func SetSDKSetAttributes(
 			"%sif %s != nil {\n",
 			indent, sourceVarPath,
 		)
-		out += setSDKForScalar(
-			cfg, r,
-			memberName,
-			targetVarName,
-			inputShape.Type,
-			cleanMemberName,
-			sourceVarPath,
-			field.ShapeRef,
-			indentLevel+1,
-		)
+		if field.IsJSON() {
+			out += setSDKForJSON(
+				memberName,
+				targetVarName,
+				sourceVarPath,
+				inputShape.MemberRefs[memberName],
+				DefaultErrorReturn,
+				indentLevel+1,
+			)
+		} else {
+			out += setSDKForScalar(
+				cfg, r,
+				memberName,
+				targetVarName,
+				inputShape.Type,
+				cleanMemberName,
+				sourceVarPath,
+				field.ShapeRef,
+				indentLevel+1,
+			)
+		}
 		out += fmt.Sprintf(
 			"%s}\n", indent,
 		)
@@ -725,6 +871,8 @@ func SetSDKSetAttributes(
 func setSDKForContainer(
 	cfg *ackgenconfig.Config,
 	r *model.CRD,
+	// How the values of SecretKeyReference fields are resolved
+	secrets secretResolution,
 	// The name of the SDK Input shape member we're outputting for
 	targetFieldName string,
 	// The variable name that we want to set a value to
@@ -740,7 +888,7 @@ func setSDKForContainer(
 	switch targetShapeRef.Shape.Type {
 	case "structure":
 		return setSDKForStruct(
-			cfg, r,
+			cfg, r, secrets,
 			targetFieldName,
 			targetVarName,
 			targetShapeRef,
@@ -750,7 +898,7 @@ func setSDKForContainer(
 		)
 	case "list":
 		return setSDKForSlice(
-			cfg, r,
+			cfg, r, secrets,
 			targetFieldName,
 			targetVarName,
 			targetShapeRef,
@@ -760,7 +908,7 @@ func setSDKForContainer(
 		)
 	case "map":
 		return setSDKForMap(
-			cfg, r,
+			cfg, r, secrets,
 			targetFieldName,
 			targetVarName,
 			targetShapeRef,
@@ -777,7 +925,7 @@ func setSDKForContainer(
 				indent, sourceVarName,
 			)
 			out += setSDKForSecret(
-				cfg, r,
+				cfg, r, secrets,
 				"",
 				targetVarName,
 				sourceVarName,
@@ -826,11 +974,14 @@ func setSDKForContainer(
 //     }
 //
 // The second case is used when the SecretKeyReference field
-// is a slice of `[]*string` in the original AWS API Input shape.
-
+// is a slice of `[]*string` in the original AWS API Input shape. The function
+// called to resolve the Secret's value and the statement returning its error
+// are those of the supplied secretResolution.
 func setSDKForSecret(
 	cfg *ackgenconfig.Config,
 	r *model.CRD,
+	// How the values of SecretKeyReference fields are resolved
+	secrets secretResolution,
 	// The name of the SDK Shape field we're setting
 	targetFieldName string,
 	// The variable name that we want to set a value on
@@ -846,14 +997,14 @@ func setSDKForSecret(
 
 	//     tmpSecret, err := rm.rr.SecretValueFromReference(ctx, ko.Spec.MasterUserPassword)
 	out += fmt.Sprintf(
-		"%s\t%s, err := rm.rr.SecretValueFromReference(ctx, %s)\n",
-		indent, secVar, sourceVarName,
+		"%s\t%s, err := %s(ctx, %s)\n",
+		indent, secVar, secrets.resolver, sourceVarName,
 	)
 	//     if err != nil {
 	//         return nil, err
 	//     }
 	out += fmt.Sprintf("%s\tif err != nil {\n", indent)
-	out += fmt.Sprintf("%s\t\treturn nil, err\n", indent)
+	out += fmt.Sprintf("%s\t\t%s\n", indent, secrets.errorReturn)
 	out += fmt.Sprintf("%s\t}\n", indent)
 	//     if tmpSecret != "" {
 	//         res.SetMasterUserPassword(tmpSecret)
@@ -874,11 +1025,82 @@ func setSDKForSecret(
 	return out
 }
 
+// setSDKForJSON returns a string of Go code that sets a target Input shape
+// member to a source variable containing a JSON document. The target member is
+// either an `aws.JSONValue`, which the JSON document is unmarshaled into, or a
+// string containing the JSON document.
+//
+// Output code will look something like this:
+//
+//   tmpJSON := aws.JSONValue{}
+//   if err := json.Unmarshal(r.ko.Spec.Conditions.Raw, &tmpJSON); err != nil {
+//       return nil, err
+//   }
+//   res.SetConditions(tmpJSON)
+//
+// or this:
+//
+//   res.SetPolicy(string(r.ko.Spec.Policy.Raw))
+//
+// JSON documents that are not JSON objects cannot be represented as an
+// `aws.JSONValue` and fail with the error returned by json.Unmarshal.
+func setSDKForJSON(
+	// The name of the SDK Shape field we're setting
+	targetFieldName string,
+	// The variable name that we want to set a value on
+	targetVarName string,
+	// The CR field that we access our source value from
+	sourceVarName string,
+	// ShapeRef of the target Input shape member
+	targetShapeRef *awssdkmodel.ShapeRef,
+	// The statement returning an error from the enclosing function
+	errorReturn string,
+	indentLevel int,
+) string {
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+	if targetShapeRef != nil && targetShapeRef.JSONValue {
+		out += fmt.Sprintf("%stmpJSON := aws.JSONValue{}\n", indent)
+		out += fmt.Sprintf(
+			"%sif err := json.Unmarshal(%s.Raw, &tmpJSON); err != nil {\n",
+			indent, sourceVarName,
+		)
+		out += fmt.Sprintf("%s\t%s\n", indent, errorReturn)
+		out += fmt.Sprintf("%s}\n", indent)
+		out += fmt.Sprintf(
+			"%s%s.Set%s(tmpJSON)\n", indent, targetVarName, targetFieldName,
+		)
+		return out
+	}
+	out += fmt.Sprintf(
+		"%s%s.Set%s(string(%s.Raw))\n",
+		indent, targetVarName, targetFieldName, sourceVarName,
+	)
+	return out
+}
+
+// attrMapValue returns the Go code for the `*string` value of an Attributes
+// map entry that is set from the supplied CR field
+func attrMapValue(
+	r *model.CRD,
+	// The name of the field in the Attributes map
+	fieldName string,
+	// The CR field that we access our source value from
+	sourceVarName string,
+) string {
+	if r.IsJSONField(fieldName) {
+		return "aws.String(string(" + sourceVarName + ".Raw))"
+	}
+	return sourceVarName
+}
+
 // setSDKForStruct returns a string of Go code that sets a target variable
 // value to a source variable when the type of the source variable is a struct.
 func setSDKForStruct(
 	cfg *ackgenconfig.Config,
 	r *model.CRD,
+	// How the values of SecretKeyReference fields are resolved
+	secrets secretResolution,
 	// The name of the CR field we're outputting for
 	targetFieldName string,
 	// The variable name that we want to set a value to
@@ -894,8 +1116,13 @@ func setSDKForStruct(
 	out := ""
 	indent := strings.Repeat("\t", indentLevel)
 	targetShape := targetShapeRef.Shape
+	// Only one member of a union may be set, so the members of a union are
+	// set in a single if/else if chain, populating only the first member set
+	// in the source struct
+	isUnion := r.IsUnionShape(targetShape)
+	memberNames := targetShape.MemberNames()
 
-	for memberIndex, memberName := range targetShape.MemberNames() {
+	for memberIndex, memberName := range memberNames {
 		memberShapeRef := targetShape.MemberRefs[memberName]
 		memberShape := memberShapeRef.Shape
 		cleanMemberNames := names.New(memberName)
@@ -903,9 +1130,15 @@ func setSDKForStruct(
 		sourceAdaptedVarName := sourceVarName + "." + cleanMemberName
 		memberFieldPath := sourceFieldPath + "." + cleanMemberName
 
-		out += fmt.Sprintf(
-			"%sif %s != nil {\n", indent, sourceAdaptedVarName,
-		)
+		if isUnion && memberIndex > 0 {
+			out += fmt.Sprintf(
+				"%s} else if %s != nil {\n", indent, sourceAdaptedVarName,
+			)
+		} else {
+			out += fmt.Sprintf(
+				"%sif %s != nil {\n", indent, sourceAdaptedVarName,
+			)
+		}
 		switch memberShape.Type {
 		case "list", "structure", "map":
 			{
@@ -920,7 +1153,7 @@ func setSDKForStruct(
 					indentLevel+1,
 				)
 				out += setSDKForContainer(
-					cfg, r,
+					cfg, r, secrets,
 					memberName,
 					memberVarName,
 					memberFieldPath,
@@ -942,12 +1175,21 @@ func setSDKForStruct(
 		default:
 			if r.IsSecretField(memberFieldPath) {
 				out += setSDKForSecret(
-					cfg, r,
+					cfg, r, secrets,
 					memberName,
 					targetVarName,
 					sourceAdaptedVarName,
 					indentLevel,
 				)
+			} else if memberShapeRef.JSONValue {
+				out += setSDKForJSON(
+					memberName,
+					targetVarName,
+					sourceAdaptedVarName,
+					memberShapeRef,
+					secrets.errorReturn,
+					indentLevel+1,
+				)
 			} else {
 				out += setSDKForScalar(
 					cfg, r,
@@ -961,6 +1203,9 @@ func setSDKForStruct(
 				)
 			}
 		}
+		if isUnion && memberIndex < len(memberNames)-1 {
+			continue
+		}
 		out += fmt.Sprintf(
 			"%s}\n", indent,
 		)
@@ -973,6 +1218,8 @@ func setSDKForStruct(
 func setSDKForSlice(
 	cfg *ackgenconfig.Config,
 	r *model.CRD,
+	// How the values of SecretKeyReference fields are resolved
+	secrets secretResolution,
 	// The name of the CR field we're outputting for
 	targetFieldName string,
 	// The variable name that we want to set a value to
@@ -1012,7 +1259,7 @@ func setSDKForSlice(
 		sourceAttributePath = sourceFieldPath+"."
 	}
 	out += setSDKForContainer(
-		cfg, r,
+		cfg, r, secrets,
 		containerFieldName,
 		elemVarName,
 		sourceAttributePath,
@@ -1038,6 +1285,8 @@ func setSDKForSlice(
 func setSDKForMap(
 	cfg *ackgenconfig.Config,
 	r *model.CRD,
+	// How the values of SecretKeyReference fields are resolved
+	secrets secretResolution,
 	// The name of the CR field we're outputting for
 	targetFieldName string,
 	// The variable name that we want to set a value to
@@ -1057,6 +1306,11 @@ func setSDKForMap(
 	valIterVarName := fmt.Sprintf("%svaliter", targetVarName)
 	keyVarName := fmt.Sprintf("%skey", targetVarName)
 	valVarName := fmt.Sprintf("%sval", targetVarName)
+	if targetShape.ValueRef.Shape.Type == "list" {
+		// The iterator variable of the slice value would otherwise shadow
+		// this map's value iterator variable
+		valVarName = fmt.Sprintf("%svals", targetVarName)
+	}
 	// for f0key, f0valiter := range r.ko.Spec.Tags {
 	out += fmt.Sprintf("%sfor %s, %s := range %s {\n", indent, keyVarName, valIterVarName, sourceVarName)
 	//		f0elem := string{}
@@ -1076,7 +1330,7 @@ func setSDKForMap(
 		containerFieldName = targetFieldName
 	}
 	out += setSDKForContainer(
-		cfg, r,
+		cfg, r, secrets,
 		containerFieldName,
 		valVarName,
 		sourceFieldPath+".",
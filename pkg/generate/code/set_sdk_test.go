@@ -97,6 +97,172 @@ func TestSetSDK_APIGWv2_Route_Create(t *testing.T) {
 	)
 }
 
+func TestSetSDK_CodeDeploy_Deployment_Create(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewGeneratorForService(t, "codedeploy")
+
+	crd := testutil.GetCRDByName(t, g, "Deployment")
+	require.NotNil(crd)
+
+	// EC2TagSetList is a list of lists of structs
+	expected := `
+	if r.ko.Spec.ApplicationName != nil {
+		res.SetApplicationName(*r.ko.Spec.ApplicationName)
+	}
+	if r.ko.Spec.AutoRollbackConfiguration != nil {
+		f1 := &svcsdk.AutoRollbackConfiguration{}
+		if r.ko.Spec.AutoRollbackConfiguration.Enabled != nil {
+			f1.SetEnabled(*r.ko.Spec.AutoRollbackConfiguration.Enabled)
+		}
+		if r.ko.Spec.AutoRollbackConfiguration.Events != nil {
+			f1f1 := []*string{}
+			for _, f1f1iter := range r.ko.Spec.AutoRollbackConfiguration.Events {
+				var f1f1elem string
+				f1f1elem = *f1f1iter
+				f1f1 = append(f1f1, &f1f1elem)
+			}
+			f1.SetEvents(f1f1)
+		}
+		res.SetAutoRollbackConfiguration(f1)
+	}
+	if r.ko.Spec.DeploymentConfigName != nil {
+		res.SetDeploymentConfigName(*r.ko.Spec.DeploymentConfigName)
+	}
+	if r.ko.Spec.DeploymentGroupName != nil {
+		res.SetDeploymentGroupName(*r.ko.Spec.DeploymentGroupName)
+	}
+	if r.ko.Spec.Description != nil {
+		res.SetDescription(*r.ko.Spec.Description)
+	}
+	if r.ko.Spec.FileExistsBehavior != nil {
+		res.SetFileExistsBehavior(*r.ko.Spec.FileExistsBehavior)
+	}
+	if r.ko.Spec.IgnoreApplicationStopFailures != nil {
+		res.SetIgnoreApplicationStopFailures(*r.ko.Spec.IgnoreApplicationStopFailures)
+	}
+	if r.ko.Spec.Revision != nil {
+		f7 := &svcsdk.RevisionLocation{}
+		if r.ko.Spec.Revision.AppSpecContent != nil {
+			f7f0 := &svcsdk.AppSpecContent{}
+			if r.ko.Spec.Revision.AppSpecContent.Content != nil {
+				f7f0.SetContent(*r.ko.Spec.Revision.AppSpecContent.Content)
+			}
+			if r.ko.Spec.Revision.AppSpecContent.SHA256 != nil {
+				f7f0.SetSha256(*r.ko.Spec.Revision.AppSpecContent.SHA256)
+			}
+			f7.SetAppSpecContent(f7f0)
+		}
+		if r.ko.Spec.Revision.GitHubLocation != nil {
+			f7f1 := &svcsdk.GitHubLocation{}
+			if r.ko.Spec.Revision.GitHubLocation.CommitID != nil {
+				f7f1.SetCommitId(*r.ko.Spec.Revision.GitHubLocation.CommitID)
+			}
+			if r.ko.Spec.Revision.GitHubLocation.Repository != nil {
+				f7f1.SetRepository(*r.ko.Spec.Revision.GitHubLocation.Repository)
+			}
+			f7.SetGitHubLocation(f7f1)
+		}
+		if r.ko.Spec.Revision.RevisionType != nil {
+			f7.SetRevisionType(*r.ko.Spec.Revision.RevisionType)
+		}
+		if r.ko.Spec.Revision.S3Location != nil {
+			f7f3 := &svcsdk.S3Location{}
+			if r.ko.Spec.Revision.S3Location.Bucket != nil {
+				f7f3.SetBucket(*r.ko.Spec.Revision.S3Location.Bucket)
+			}
+			if r.ko.Spec.Revision.S3Location.BundleType != nil {
+				f7f3.SetBundleType(*r.ko.Spec.Revision.S3Location.BundleType)
+			}
+			if r.ko.Spec.Revision.S3Location.ETag != nil {
+				f7f3.SetETag(*r.ko.Spec.Revision.S3Location.ETag)
+			}
+			if r.ko.Spec.Revision.S3Location.Key != nil {
+				f7f3.SetKey(*r.ko.Spec.Revision.S3Location.Key)
+			}
+			if r.ko.Spec.Revision.S3Location.Version != nil {
+				f7f3.SetVersion(*r.ko.Spec.Revision.S3Location.Version)
+			}
+			f7.SetS3Location(f7f3)
+		}
+		if r.ko.Spec.Revision.String != nil {
+			f7f4 := &svcsdk.RawString{}
+			if r.ko.Spec.Revision.String.Content != nil {
+				f7f4.SetContent(*r.ko.Spec.Revision.String.Content)
+			}
+			if r.ko.Spec.Revision.String.SHA256 != nil {
+				f7f4.SetSha256(*r.ko.Spec.Revision.String.SHA256)
+			}
+			f7.SetString_(f7f4)
+		}
+		res.SetRevision(f7)
+	}
+	if r.ko.Spec.TargetInstances != nil {
+		f8 := &svcsdk.TargetInstances{}
+		if r.ko.Spec.TargetInstances.AutoScalingGroups != nil {
+			f8f0 := []*string{}
+			for _, f8f0iter := range r.ko.Spec.TargetInstances.AutoScalingGroups {
+				var f8f0elem string
+				f8f0elem = *f8f0iter
+				f8f0 = append(f8f0, &f8f0elem)
+			}
+			f8.SetAutoScalingGroups(f8f0)
+		}
+		if r.ko.Spec.TargetInstances.EC2TagSet != nil {
+			f8f1 := &svcsdk.EC2TagSet{}
+			if r.ko.Spec.TargetInstances.EC2TagSet.EC2TagSetList != nil {
+				f8f1f0 := [][]*svcsdk.EC2TagFilter{}
+				for _, f8f1f0iter := range r.ko.Spec.TargetInstances.EC2TagSet.EC2TagSetList {
+					f8f1f0elem := []*svcsdk.EC2TagFilter{}
+					for _, f8f1f0elemiter := range f8f1f0iter {
+						f8f1f0elemelem := &svcsdk.EC2TagFilter{}
+						if f8f1f0elemiter.Key != nil {
+							f8f1f0elemelem.SetKey(*f8f1f0elemiter.Key)
+						}
+						if f8f1f0elemiter.Type != nil {
+							f8f1f0elemelem.SetType(*f8f1f0elemiter.Type)
+						}
+						if f8f1f0elemiter.Value != nil {
+							f8f1f0elemelem.SetValue(*f8f1f0elemiter.Value)
+						}
+						f8f1f0elem = append(f8f1f0elem, f8f1f0elemelem)
+					}
+					f8f1f0 = append(f8f1f0, f8f1f0elem)
+				}
+				f8f1.SetEc2TagSetList(f8f1f0)
+			}
+			f8.SetEc2TagSet(f8f1)
+		}
+		if r.ko.Spec.TargetInstances.TagFilters != nil {
+			f8f2 := []*svcsdk.EC2TagFilter{}
+			for _, f8f2iter := range r.ko.Spec.TargetInstances.TagFilters {
+				f8f2elem := &svcsdk.EC2TagFilter{}
+				if f8f2iter.Key != nil {
+					f8f2elem.SetKey(*f8f2iter.Key)
+				}
+				if f8f2iter.Type != nil {
+					f8f2elem.SetType(*f8f2iter.Type)
+				}
+				if f8f2iter.Value != nil {
+					f8f2elem.SetValue(*f8f2iter.Value)
+				}
+				f8f2 = append(f8f2, f8f2elem)
+			}
+			f8.SetTagFilters(f8f2)
+		}
+		res.SetTargetInstances(f8)
+	}
+	if r.ko.Spec.UpdateOutdatedInstancesOnly != nil {
+		res.SetUpdateOutdatedInstancesOnly(*r.ko.Spec.UpdateOutdatedInstancesOnly)
+	}
+`
+	assert.Equal(
+		expected,
+		code.SetSDK(crd.Config(), crd, model.OpTypeCreate, "r.ko", "res", 1),
+	)
+}
+
 func TestSetSDK_DynamoDB_Table_Create(t *testing.T) {
 	assert := assert.New(t)
 	require := require.New(t)
@@ -1152,6 +1318,136 @@ func TestSetSDK_Elasticache_User_Create_Override_Values(t *testing.T) {
 	)
 }
 
+func TestSetSDK_Lambda_EventSourceMapping_Create(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewGeneratorForService(t, "lambda")
+
+	crd := testutil.GetCRDByName(t, g, "EventSourceMapping")
+	require.NotNil(crd)
+
+	// SelfManagedEventSource.Endpoints is a map of lists of strings
+	expected := `
+	if r.ko.Spec.BatchSize != nil {
+		res.SetBatchSize(*r.ko.Spec.BatchSize)
+	}
+	if r.ko.Spec.BisectBatchOnFunctionError != nil {
+		res.SetBisectBatchOnFunctionError(*r.ko.Spec.BisectBatchOnFunctionError)
+	}
+	if r.ko.Spec.DestinationConfig != nil {
+		f2 := &svcsdk.DestinationConfig{}
+		if r.ko.Spec.DestinationConfig.OnFailure != nil {
+			f2f0 := &svcsdk.OnFailure{}
+			if r.ko.Spec.DestinationConfig.OnFailure.Destination != nil {
+				f2f0.SetDestination(*r.ko.Spec.DestinationConfig.OnFailure.Destination)
+			}
+			f2.SetOnFailure(f2f0)
+		}
+		if r.ko.Spec.DestinationConfig.OnSuccess != nil {
+			f2f1 := &svcsdk.OnSuccess{}
+			if r.ko.Spec.DestinationConfig.OnSuccess.Destination != nil {
+				f2f1.SetDestination(*r.ko.Spec.DestinationConfig.OnSuccess.Destination)
+			}
+			f2.SetOnSuccess(f2f1)
+		}
+		res.SetDestinationConfig(f2)
+	}
+	if r.ko.Spec.Enabled != nil {
+		res.SetEnabled(*r.ko.Spec.Enabled)
+	}
+	if r.ko.Spec.EventSourceARN != nil {
+		res.SetEventSourceArn(*r.ko.Spec.EventSourceARN)
+	}
+	if r.ko.Spec.FunctionName != nil {
+		res.SetFunctionName(*r.ko.Spec.FunctionName)
+	}
+	if r.ko.Spec.FunctionResponseTypes != nil {
+		f6 := []*string{}
+		for _, f6iter := range r.ko.Spec.FunctionResponseTypes {
+			var f6elem string
+			f6elem = *f6iter
+			f6 = append(f6, &f6elem)
+		}
+		res.SetFunctionResponseTypes(f6)
+	}
+	if r.ko.Spec.MaximumBatchingWindowInSeconds != nil {
+		res.SetMaximumBatchingWindowInSeconds(*r.ko.Spec.MaximumBatchingWindowInSeconds)
+	}
+	if r.ko.Spec.MaximumRecordAgeInSeconds != nil {
+		res.SetMaximumRecordAgeInSeconds(*r.ko.Spec.MaximumRecordAgeInSeconds)
+	}
+	if r.ko.Spec.MaximumRetryAttempts != nil {
+		res.SetMaximumRetryAttempts(*r.ko.Spec.MaximumRetryAttempts)
+	}
+	if r.ko.Spec.ParallelizationFactor != nil {
+		res.SetParallelizationFactor(*r.ko.Spec.ParallelizationFactor)
+	}
+	if r.ko.Spec.Queues != nil {
+		f11 := []*string{}
+		for _, f11iter := range r.ko.Spec.Queues {
+			var f11elem string
+			f11elem = *f11iter
+			f11 = append(f11, &f11elem)
+		}
+		res.SetQueues(f11)
+	}
+	if r.ko.Spec.SelfManagedEventSource != nil {
+		f12 := &svcsdk.SelfManagedEventSource{}
+		if r.ko.Spec.SelfManagedEventSource.Endpoints != nil {
+			f12f0 := map[string][]*string{}
+			for f12f0key, f12f0valiter := range r.ko.Spec.SelfManagedEventSource.Endpoints {
+				f12f0vals := []*string{}
+				for _, f12f0valsiter := range f12f0valiter {
+					var f12f0valselem string
+					f12f0valselem = *f12f0valsiter
+					f12f0vals = append(f12f0vals, &f12f0valselem)
+				}
+				f12f0[f12f0key] = f12f0vals
+			}
+			f12.SetEndpoints(f12f0)
+		}
+		res.SetSelfManagedEventSource(f12)
+	}
+	if r.ko.Spec.SourceAccessConfigurations != nil {
+		f13 := []*svcsdk.SourceAccessConfiguration{}
+		for _, f13iter := range r.ko.Spec.SourceAccessConfigurations {
+			f13elem := &svcsdk.SourceAccessConfiguration{}
+			if f13iter.Type != nil {
+				f13elem.SetType(*f13iter.Type)
+			}
+			if f13iter.URI != nil {
+				f13elem.SetURI(*f13iter.URI)
+			}
+			f13 = append(f13, f13elem)
+		}
+		res.SetSourceAccessConfigurations(f13)
+	}
+	if r.ko.Spec.StartingPosition != nil {
+		res.SetStartingPosition(*r.ko.Spec.StartingPosition)
+	}
+	if r.ko.Spec.StartingPositionTimestamp != nil {
+		res.SetStartingPositionTimestamp(r.ko.Spec.StartingPositionTimestamp.Time)
+	}
+	if r.ko.Spec.Topics != nil {
+		f16 := []*string{}
+		for _, f16iter := range r.ko.Spec.Topics {
+			var f16elem string
+			f16elem = *f16iter
+			f16 = append(f16, &f16elem)
+		}
+		res.SetTopics(f16)
+	}
+	if r.ko.Spec.TumblingWindowInSeconds != nil {
+		res.SetTumblingWindowInSeconds(*r.ko.Spec.TumblingWindowInSeconds)
+	}
+`
+	assert.Equal(
+		expected,
+		code.SetSDK(crd.Config(), crd, model.OpTypeCreate, "r.ko", "res", 1),
+	)
+}
+
 func TestSetSDK_RDS_DBInstance_Create(t *testing.T) {
 	assert := assert.New(t)
 	require := require.New(t)
@@ -1395,6 +1691,40 @@ func TestSetSDK_S3_Bucket_Create(t *testing.T) {
 	)
 }
 
+func TestSetSDK_S3Control_PublicAccessBlock_Create(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewGeneratorForService(t, "s3control")
+
+	crd := testutil.GetCRDByName(t, g, "PublicAccessBlock")
+	require.NotNil(crd)
+
+	// The AccountId of the singleton resource is set from the resource
+	// manager's AWS account ID
+	expected := `
+	res.SetAccountId(string(rm.awsAccountID))
+	f1 := &svcsdk.PublicAccessBlockConfiguration{}
+	if r.ko.Spec.BlockPublicACLs != nil {
+		f1.SetBlockPublicAcls(*r.ko.Spec.BlockPublicACLs)
+	}
+	if r.ko.Spec.BlockPublicPolicy != nil {
+		f1.SetBlockPublicPolicy(*r.ko.Spec.BlockPublicPolicy)
+	}
+	if r.ko.Spec.IgnorePublicACLs != nil {
+		f1.SetIgnorePublicAcls(*r.ko.Spec.IgnorePublicACLs)
+	}
+	if r.ko.Spec.RestrictPublicBuckets != nil {
+		f1.SetRestrictPublicBuckets(*r.ko.Spec.RestrictPublicBuckets)
+	}
+	res.SetPublicAccessBlockConfiguration(f1)
+`
+	assert.Equal(
+		expected,
+		code.SetSDK(crd.Config(), crd, model.OpTypeCreate, "r.ko", "res", 1),
+	)
+}
+
 func TestSetSDK_S3_Bucket_Delete(t *testing.T) {
 	assert := assert.New(t)
 	require := require.New(t)
@@ -1502,6 +1832,8 @@ func TestSetSDK_SQS_Queue_Create(t *testing.T) {
 	crd := testutil.GetCRDByName(t, g, "Queue")
 	require.NotNil(crd)
 
+	// The Policy field is configured with `type: json` and is unpacked from
+	// the structured JSON document into the Attributes map as a string
 	expected := `
 	attrMap := map[string]*string{}
 	if r.ko.Spec.ContentBasedDeduplication != nil {
@@ -1526,7 +1858,7 @@ func TestSetSDK_SQS_Queue_Create(t *testing.T) {
 		attrMap["MessageRetentionPeriod"] = r.ko.Spec.MessageRetentionPeriod
 	}
 	if r.ko.Spec.Policy != nil {
-		attrMap["Policy"] = r.ko.Spec.Policy
+		attrMap["Policy"] = aws.String(string(r.ko.Spec.Policy.Raw))
 	}
 	if r.ko.Spec.ReceiveMessageWaitTimeSeconds != nil {
 		attrMap["ReceiveMessageWaitTimeSeconds"] = r.ko.Spec.ReceiveMessageWaitTimeSeconds
@@ -1778,3 +2110,320 @@ func TestSetSDK_MQ_Broker_Create(t *testing.T) {
 		code.SetSDK(crd.Config(), crd, model.OpTypeCreate, "r.ko", "res", 1),
 	)
 }
+
+func TestSetSDKAction_SageMaker_NotebookInstance(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewGeneratorForService(t, "sagemaker")
+
+	crd := testutil.GetCRDByName(t, g, "NotebookInstance")
+	require.NotNil(crd)
+
+	actions := crd.Actions()
+	require.Len(actions, 2)
+	assert.Equal("StopNotebookInstance", actions[1].Op.Name)
+
+	expected := `
+		if desired.ko.Spec.NotebookInstanceName != nil {
+			input.SetNotebookInstanceName(*desired.ko.Spec.NotebookInstanceName)
+		}
+`
+	assert.Equal(
+		expected,
+		code.SetSDKAction(crd.Config(), crd, actions[1], "desired.ko", "input", 2),
+	)
+}
+
+func TestSetSDK_AppMesh_VirtualService_Create(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewGeneratorForService(t, "appmesh")
+
+	crd := testutil.GetCRDByName(t, g, "VirtualService")
+	require.NotNil(crd)
+
+	// VirtualServiceProvider is a union, so only one of its VirtualNode and
+	// VirtualRouter members is set in the input shape
+	expected := `
+	if r.ko.Spec.ClientToken != nil {
+		res.SetClientToken(*r.ko.Spec.ClientToken)
+	}
+	if r.ko.Spec.MeshName != nil {
+		res.SetMeshName(*r.ko.Spec.MeshName)
+	}
+	if r.ko.Spec.MeshOwner != nil {
+		res.SetMeshOwner(*r.ko.Spec.MeshOwner)
+	}
+	if r.ko.Spec.Spec != nil {
+		f3 := &svcsdk.VirtualServiceSpec{}
+		if r.ko.Spec.Spec.Provider != nil {
+			f3f0 := &svcsdk.VirtualServiceProvider{}
+			if r.ko.Spec.Spec.Provider.VirtualNode != nil {
+				f3f0f0 := &svcsdk.VirtualNodeServiceProvider{}
+				if r.ko.Spec.Spec.Provider.VirtualNode.VirtualNodeName != nil {
+					f3f0f0.SetVirtualNodeName(*r.ko.Spec.Spec.Provider.VirtualNode.VirtualNodeName)
+				}
+				f3f0.SetVirtualNode(f3f0f0)
+			} else if r.ko.Spec.Spec.Provider.VirtualRouter != nil {
+				f3f0f1 := &svcsdk.VirtualRouterServiceProvider{}
+				if r.ko.Spec.Spec.Provider.VirtualRouter.VirtualRouterName != nil {
+					f3f0f1.SetVirtualRouterName(*r.ko.Spec.Spec.Provider.VirtualRouter.VirtualRouterName)
+				}
+				f3f0.SetVirtualRouter(f3f0f1)
+			}
+			f3.SetProvider(f3f0)
+		}
+		res.SetSpec(f3)
+	}
+	if r.ko.Spec.Tags != nil {
+		f4 := []*svcsdk.TagRef{}
+		for _, f4iter := range r.ko.Spec.Tags {
+			f4elem := &svcsdk.TagRef{}
+			if f4iter.Key != nil {
+				f4elem.SetKey(*f4iter.Key)
+			}
+			if f4iter.Value != nil {
+				f4elem.SetValue(*f4iter.Value)
+			}
+			f4 = append(f4, f4elem)
+		}
+		res.SetTags(f4)
+	}
+	if r.ko.Spec.VirtualServiceName != nil {
+		res.SetVirtualServiceName(*r.ko.Spec.VirtualServiceName)
+	}
+`
+	assert.Equal(
+		expected,
+		code.SetSDK(crd.Config(), crd, model.OpTypeCreate, "r.ko", "res", 1),
+	)
+}
+
+func TestSetSDK_SageMaker_FlowDefinition_Create(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewGeneratorForService(t, "sagemaker")
+
+	crd := testutil.GetCRDByName(t, g, "FlowDefinition")
+	require.NotNil(crd)
+
+	// The HumanLoopActivationConditions member of the
+	// HumanLoopActivationConditionsConfig shape is an aws.JSONValue, which is
+	// unmarshaled from the structured JSON document
+	expected := `
+	if r.ko.Spec.FlowDefinitionName != nil {
+		res.SetFlowDefinitionName(*r.ko.Spec.FlowDefinitionName)
+	}
+	if r.ko.Spec.HumanLoopActivationConfig != nil {
+		f1 := &svcsdk.HumanLoopActivationConfig{}
+		if r.ko.Spec.HumanLoopActivationConfig.HumanLoopActivationConditionsConfig != nil {
+			f1f0 := &svcsdk.HumanLoopActivationConditionsConfig{}
+			if r.ko.Spec.HumanLoopActivationConfig.HumanLoopActivationConditionsConfig.HumanLoopActivationConditions != nil {
+				tmpJSON := aws.JSONValue{}
+				if err := json.Unmarshal(r.ko.Spec.HumanLoopActivationConfig.HumanLoopActivationConditionsConfig.HumanLoopActivationConditions.Raw, &tmpJSON); err != nil {
+					return nil, err
+				}
+				f1f0.SetHumanLoopActivationConditions(tmpJSON)
+			}
+			f1.SetHumanLoopActivationConditionsConfig(f1f0)
+		}
+		res.SetHumanLoopActivationConfig(f1)
+	}
+	if r.ko.Spec.HumanLoopConfig != nil {
+		f2 := &svcsdk.HumanLoopConfig{}
+		if r.ko.Spec.HumanLoopConfig.HumanTaskUiARN != nil {
+			f2.SetHumanTaskUiArn(*r.ko.Spec.HumanLoopConfig.HumanTaskUiARN)
+		}
+		if r.ko.Spec.HumanLoopConfig.PublicWorkforceTaskPrice != nil {
+			f2f1 := &svcsdk.PublicWorkforceTaskPrice{}
+			if r.ko.Spec.HumanLoopConfig.PublicWorkforceTaskPrice.AmountInUsd != nil {
+				f2f1f0 := &svcsdk.USD{}
+				if r.ko.Spec.HumanLoopConfig.PublicWorkforceTaskPrice.AmountInUsd.Cents != nil {
+					f2f1f0.SetCents(*r.ko.Spec.HumanLoopConfig.PublicWorkforceTaskPrice.AmountInUsd.Cents)
+				}
+				if r.ko.Spec.HumanLoopConfig.PublicWorkforceTaskPrice.AmountInUsd.Dollars != nil {
+					f2f1f0.SetDollars(*r.ko.Spec.HumanLoopConfig.PublicWorkforceTaskPrice.AmountInUsd.Dollars)
+				}
+				if r.ko.Spec.HumanLoopConfig.PublicWorkforceTaskPrice.AmountInUsd.TenthFractionsOfACent != nil {
+					f2f1f0.SetTenthFractionsOfACent(*r.ko.Spec.HumanLoopConfig.PublicWorkforceTaskPrice.AmountInUsd.TenthFractionsOfACent)
+				}
+				f2f1.SetAmountInUsd(f2f1f0)
+			}
+			f2.SetPublicWorkforceTaskPrice(f2f1)
+		}
+		if r.ko.Spec.HumanLoopConfig.TaskAvailabilityLifetimeInSeconds != nil {
+			f2.SetTaskAvailabilityLifetimeInSeconds(*r.ko.Spec.HumanLoopConfig.TaskAvailabilityLifetimeInSeconds)
+		}
+		if r.ko.Spec.HumanLoopConfig.TaskCount != nil {
+			f2.SetTaskCount(*r.ko.Spec.HumanLoopConfig.TaskCount)
+		}
+		if r.ko.Spec.HumanLoopConfig.TaskDescription != nil {
+			f2.SetTaskDescription(*r.ko.Spec.HumanLoopConfig.TaskDescription)
+		}
+		if r.ko.Spec.HumanLoopConfig.TaskKeywords != nil {
+			f2f5 := []*string{}
+			for _, f2f5iter := range r.ko.Spec.HumanLoopConfig.TaskKeywords {
+				var f2f5elem string
+				f2f5elem = *f2f5iter
+				f2f5 = append(f2f5, &f2f5elem)
+			}
+			f2.SetTaskKeywords(f2f5)
+		}
+		if r.ko.Spec.HumanLoopConfig.TaskTimeLimitInSeconds != nil {
+			f2.SetTaskTimeLimitInSeconds(*r.ko.Spec.HumanLoopConfig.TaskTimeLimitInSeconds)
+		}
+		if r.ko.Spec.HumanLoopConfig.TaskTitle != nil {
+			f2.SetTaskTitle(*r.ko.Spec.HumanLoopConfig.TaskTitle)
+		}
+		if r.ko.Spec.HumanLoopConfig.WorkteamARN != nil {
+			f2.SetWorkteamArn(*r.ko.Spec.HumanLoopConfig.WorkteamARN)
+		}
+		res.SetHumanLoopConfig(f2)
+	}
+	if r.ko.Spec.HumanLoopRequestSource != nil {
+		f3 := &svcsdk.HumanLoopRequestSource{}
+		if r.ko.Spec.HumanLoopRequestSource.AWSManagedHumanLoopRequestSource != nil {
+			f3.SetAwsManagedHumanLoopRequestSource(*r.ko.Spec.HumanLoopRequestSource.AWSManagedHumanLoopRequestSource)
+		}
+		res.SetHumanLoopRequestSource(f3)
+	}
+	if r.ko.Spec.OutputConfig != nil {
+		f4 := &svcsdk.FlowDefinitionOutputConfig{}
+		if r.ko.Spec.OutputConfig.KMSKeyID != nil {
+			f4.SetKmsKeyId(*r.ko.Spec.OutputConfig.KMSKeyID)
+		}
+		if r.ko.Spec.OutputConfig.S3OutputPath != nil {
+			f4.SetS3OutputPath(*r.ko.Spec.OutputConfig.S3OutputPath)
+		}
+		res.SetOutputConfig(f4)
+	}
+	if r.ko.Spec.RoleARN != nil {
+		res.SetRoleArn(*r.ko.Spec.RoleARN)
+	}
+`
+	assert.Equal(
+		expected,
+		code.SetSDK(crd.Config(), crd, model.OpTypeCreate, "r.ko", "res", 1),
+	)
+}
+
+func TestSetSDK_CloudFront_CachePolicy_Update(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewGeneratorForService(t, "cloudfront")
+
+	crd := testutil.GetCRDByName(t, g, "CachePolicy")
+	require.NotNil(crd)
+
+	// The members of the CachePolicyConfig wrapper are top-level Spec fields
+	// that need to be nested back into the wrapper struct
+	expected := `
+	f0 := &svcsdk.CachePolicyConfig{}
+	if r.ko.Spec.Comment != nil {
+		f0.SetComment(*r.ko.Spec.Comment)
+	}
+	if r.ko.Spec.DefaultTTL != nil {
+		f0.SetDefaultTTL(*r.ko.Spec.DefaultTTL)
+	}
+	if r.ko.Spec.MaxTTL != nil {
+		f0.SetMaxTTL(*r.ko.Spec.MaxTTL)
+	}
+	if r.ko.Spec.MinTTL != nil {
+		f0.SetMinTTL(*r.ko.Spec.MinTTL)
+	}
+	if r.ko.Spec.Name != nil {
+		f0.SetName(*r.ko.Spec.Name)
+	}
+	if r.ko.Spec.ParametersInCacheKeyAndForwardedToOrigin != nil {
+		f0f5 := &svcsdk.ParametersInCacheKeyAndForwardedToOrigin{}
+		if r.ko.Spec.ParametersInCacheKeyAndForwardedToOrigin.CookiesConfig != nil {
+			f0f5f0 := &svcsdk.CachePolicyCookiesConfig{}
+			if r.ko.Spec.ParametersInCacheKeyAndForwardedToOrigin.CookiesConfig.CookieBehavior != nil {
+				f0f5f0.SetCookieBehavior(*r.ko.Spec.ParametersInCacheKeyAndForwardedToOrigin.CookiesConfig.CookieBehavior)
+			}
+			if r.ko.Spec.ParametersInCacheKeyAndForwardedToOrigin.CookiesConfig.Cookies != nil {
+				f0f5f0f1 := &svcsdk.CookieNames{}
+				if r.ko.Spec.ParametersInCacheKeyAndForwardedToOrigin.CookiesConfig.Cookies.Items != nil {
+					f0f5f0f1f0 := []*string{}
+					for _, f0f5f0f1f0iter := range r.ko.Spec.ParametersInCacheKeyAndForwardedToOrigin.CookiesConfig.Cookies.Items {
+						var f0f5f0f1f0elem string
+						f0f5f0f1f0elem = *f0f5f0f1f0iter
+						f0f5f0f1f0 = append(f0f5f0f1f0, &f0f5f0f1f0elem)
+					}
+					f0f5f0f1.SetItems(f0f5f0f1f0)
+				}
+				if r.ko.Spec.ParametersInCacheKeyAndForwardedToOrigin.CookiesConfig.Cookies.Quantity != nil {
+					f0f5f0f1.SetQuantity(*r.ko.Spec.ParametersInCacheKeyAndForwardedToOrigin.CookiesConfig.Cookies.Quantity)
+				}
+				f0f5f0.SetCookies(f0f5f0f1)
+			}
+			f0f5.SetCookiesConfig(f0f5f0)
+		}
+		if r.ko.Spec.ParametersInCacheKeyAndForwardedToOrigin.EnableAcceptEncodingBrotli != nil {
+			f0f5.SetEnableAcceptEncodingBrotli(*r.ko.Spec.ParametersInCacheKeyAndForwardedToOrigin.EnableAcceptEncodingBrotli)
+		}
+		if r.ko.Spec.ParametersInCacheKeyAndForwardedToOrigin.EnableAcceptEncodingGzip != nil {
+			f0f5.SetEnableAcceptEncodingGzip(*r.ko.Spec.ParametersInCacheKeyAndForwardedToOrigin.EnableAcceptEncodingGzip)
+		}
+		if r.ko.Spec.ParametersInCacheKeyAndForwardedToOrigin.HeadersConfig != nil {
+			f0f5f3 := &svcsdk.CachePolicyHeadersConfig{}
+			if r.ko.Spec.ParametersInCacheKeyAndForwardedToOrigin.HeadersConfig.HeaderBehavior != nil {
+				f0f5f3.SetHeaderBehavior(*r.ko.Spec.ParametersInCacheKeyAndForwardedToOrigin.HeadersConfig.HeaderBehavior)
+			}
+			if r.ko.Spec.ParametersInCacheKeyAndForwardedToOrigin.HeadersConfig.Headers != nil {
+				f0f5f3f1 := &svcsdk.Headers{}
+				if r.ko.Spec.ParametersInCacheKeyAndForwardedToOrigin.HeadersConfig.Headers.Items != nil {
+					f0f5f3f1f0 := []*string{}
+					for _, f0f5f3f1f0iter := range r.ko.Spec.ParametersInCacheKeyAndForwardedToOrigin.HeadersConfig.Headers.Items {
+						var f0f5f3f1f0elem string
+						f0f5f3f1f0elem = *f0f5f3f1f0iter
+						f0f5f3f1f0 = append(f0f5f3f1f0, &f0f5f3f1f0elem)
+					}
+					f0f5f3f1.SetItems(f0f5f3f1f0)
+				}
+				if r.ko.Spec.ParametersInCacheKeyAndForwardedToOrigin.HeadersConfig.Headers.Quantity != nil {
+					f0f5f3f1.SetQuantity(*r.ko.Spec.ParametersInCacheKeyAndForwardedToOrigin.HeadersConfig.Headers.Quantity)
+				}
+				f0f5f3.SetHeaders(f0f5f3f1)
+			}
+			f0f5.SetHeadersConfig(f0f5f3)
+		}
+		if r.ko.Spec.ParametersInCacheKeyAndForwardedToOrigin.QueryStringsConfig != nil {
+			f0f5f4 := &svcsdk.CachePolicyQueryStringsConfig{}
+			if r.ko.Spec.ParametersInCacheKeyAndForwardedToOrigin.QueryStringsConfig.QueryStringBehavior != nil {
+				f0f5f4.SetQueryStringBehavior(*r.ko.Spec.ParametersInCacheKeyAndForwardedToOrigin.QueryStringsConfig.QueryStringBehavior)
+			}
+			if r.ko.Spec.ParametersInCacheKeyAndForwardedToOrigin.QueryStringsConfig.QueryStrings != nil {
+				f0f5f4f1 := &svcsdk.QueryStringNames{}
+				if r.ko.Spec.ParametersInCacheKeyAndForwardedToOrigin.QueryStringsConfig.QueryStrings.Items != nil {
+					f0f5f4f1f0 := []*string{}
+					for _, f0f5f4f1f0iter := range r.ko.Spec.ParametersInCacheKeyAndForwardedToOrigin.QueryStringsConfig.QueryStrings.Items {
+						var f0f5f4f1f0elem string
+						f0f5f4f1f0elem = *f0f5f4f1f0iter
+						f0f5f4f1f0 = append(f0f5f4f1f0, &f0f5f4f1f0elem)
+					}
+					f0f5f4f1.SetItems(f0f5f4f1f0)
+				}
+				if r.ko.Spec.ParametersInCacheKeyAndForwardedToOrigin.QueryStringsConfig.QueryStrings.Quantity != nil {
+					f0f5f4f1.SetQuantity(*r.ko.Spec.ParametersInCacheKeyAndForwardedToOrigin.QueryStringsConfig.QueryStrings.Quantity)
+				}
+				f0f5f4.SetQueryStrings(f0f5f4f1)
+			}
+			f0f5.SetQueryStringsConfig(f0f5f4)
+		}
+		f0.SetParametersInCacheKeyAndForwardedToOrigin(f0f5)
+	}
+	res.SetCachePolicyConfig(f0)
+	if r.ko.Status.ID != nil {
+		res.SetId(*r.ko.Status.ID)
+	}
+`
+	assert.Equal(
+		expected,
+		code.SetSDK(crd.Config(), crd, model.OpTypeUpdate, "r.ko", "res", 1),
+	)
+}
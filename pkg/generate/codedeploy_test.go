@@ -14,11 +14,15 @@
 package generate_test
 
 import (
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/aws-controllers-k8s/code-generator/pkg/generate"
+	ackgenerate "github.com/aws-controllers-k8s/code-generator/pkg/generate/ack"
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
 	"github.com/aws-controllers-k8s/code-generator/pkg/testutil"
 )
 
@@ -128,3 +132,93 @@ func TestCodeDeploy_Deployment(t *testing.T) {
 	}
 	assert.Equal(expPrinterColNames, gotPrinterColNames)
 }
+
+func TestCodeDeploy_Deployment_ListOfLists(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewGeneratorForService(t, "codedeploy")
+
+	crd := testutil.GetCRDByName(t, g, "Deployment")
+	require.NotNil(crd)
+
+	// EC2TagSet.EC2TagSetList is a list of lists of EC2TagFilter structs
+	field := crd.Fields["TargetInstances.EC2TagSet.EC2TagSetList"]
+	require.NotNil(field)
+	assert.Equal("[][]*EC2TagFilter", field.GoType)
+	assert.Equal("[][]*codedeploy.EC2TagFilter", field.GoTypeWithPkgName)
+
+	tdef := testutil.GetTypeDefByName(t, g, "EC2TagSet")
+	require.NotNil(tdef)
+
+	attr := tdef.Attrs["Ec2TagSetList"]
+	require.NotNil(attr)
+	assert.Equal("[][]*EC2TagFilter", attr.GoType)
+}
+
+func TestCodeDeploy_Deployment_Deprecated(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewGeneratorForService(t, "codedeploy")
+
+	// DeploymentInfo.AdditionalDeploymentStatusInfo is deprecated and
+	// dropped from the API model of a new API version
+	deploymentInfo := g.SDKAPI.API.Shapes["DeploymentInfo"]
+	require.NotNil(deploymentInfo)
+	assert.NotContains(deploymentInfo.MemberRefs, "AdditionalDeploymentStatusInfo")
+
+	// RevisionLocation.String_ refers to the deprecated RawString shape and
+	// is kept by the generator.yaml
+	tdef := testutil.GetTypeDefByName(t, g, "RevisionLocation")
+	require.NotNil(tdef)
+
+	attr := tdef.Attrs["String_"]
+	require.NotNil(attr)
+	assert.True(attr.IsDeprecated())
+	expMsg := "RawString and String revision type are deprecated, use AppSpecContent type instead."
+	assert.Equal(expMsg, attr.DeprecatedMessage())
+	assert.Equal(
+		`+ack:deprecated:warning="RawString and String revision type are deprecated, use AppSpecContent type instead."`,
+		attr.DeprecationMarker(),
+	)
+	assert.False(tdef.Attrs["AppSpecContent"].IsDeprecated())
+
+	dfields, err := g.GetDeprecatedFields()
+	require.Nil(err)
+	require.Len(dfields, 1)
+	assert.Equal("RevisionLocation.String", dfields[0].Path)
+	assert.Equal(expMsg, dfields[0].Message)
+}
+
+func TestCodeDeploy_Deployment_Deprecated_ReleasedAPIVersion(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	path := testutil.TestdataPath()
+	sdkAPI, err := model.NewSDKHelper(path).API("codedeploy")
+	require.Nil(err)
+	generatorConfigPath := filepath.Join(
+		path, "models", "apis", "codedeploy", "0000-00-00", "generator.yaml",
+	)
+	defaultConfig := ackgenerate.DefaultConfig
+	defaultConfig.Deprecated.ReleasedAPIVersions = []string{"v1alpha1"}
+
+	g, err := generate.New(sdkAPI, "v1alpha1", generatorConfigPath, defaultConfig)
+	require.Nil(err)
+
+	// Deprecated members of an API version that has already been released
+	// are kept, since dropping them would remove fields from released CRDs
+	deploymentInfo := g.SDKAPI.API.Shapes["DeploymentInfo"]
+	require.NotNil(deploymentInfo)
+	assert.Contains(deploymentInfo.MemberRefs, "AdditionalDeploymentStatusInfo")
+
+	dfields, err := g.GetDeprecatedFields()
+	require.Nil(err)
+	paths := []string{}
+	for _, dfield := range dfields {
+		paths = append(paths, dfield.Path)
+	}
+	assert.Contains(paths, "DeploymentInfo.AdditionalDeploymentStatusInfo")
+	assert.Contains(paths, "RevisionLocation.String")
+}
@@ -14,9 +14,12 @@
 package config
 
 import (
+	"fmt"
 	"io/ioutil"
 
 	"github.com/ghodss/yaml"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/util"
 )
 
 // Config represents instructions to the ACK code generator for a particular
@@ -27,6 +30,9 @@ type Config struct {
 	Resources map[string]ResourceConfig `json:"resources"`
 	// CRDs to ignore. ACK generator would skip these resources.
 	Ignore IgnoreSpec `json:"ignore"`
+	// CRDs to generate. When set, the generator skips all other resources,
+	// including resources added to the API by newer aws-sdk-go releases.
+	Include IncludeSpec `json:"include,omitempty"`
 	// Contains generator instructions for individual API operations.
 	Operations map[string]OperationConfig `json:"operations"`
 	// PrefixConfig contains the prefixes to access certain fields in the generated
@@ -39,6 +45,36 @@ type Config struct {
 	// SetManyOutput function fails with NotFound error.
 	// Default is "return nil, ackerr.NotFound"
 	SetManyOutputNotFoundErrReturn string `json:"set_many_output_notfound_err_return,omitempty"`
+	// Deprecated contains instructions for handling members that the AWS API
+	// model marks as deprecated
+	Deprecated DeprecatedSpec `json:"deprecated,omitempty"`
+	// UnionValidation instructs the code generator to add a CEL validation
+	// rule to union structs, rejecting custom resources that set more or
+	// less than one of the struct's fields. The rule requires controller-gen
+	// v0.9.0 or later to render, and Kubernetes 1.25 or later to enforce.
+	// Default is false.
+	UnionValidation bool `json:"union_validation,omitempty"`
+	// GenerateTarget is the target that the code generator generates
+	// resources for, e.g. "apis" for ACK or "crossplane" for Crossplane.
+	// Resources whose `generate` configuration does not contain the target
+	// are ignored. It is set by the default configuration of each flavor of
+	// the code generator and cannot be set in the generator.yaml file.
+	GenerateTarget string `json:"-"`
+}
+
+// IncludeSpec represents instructions to the ACK code generator to generate
+// only an allow-list of resources of an AWS service API
+//
+// ```yaml
+// include:
+//   resource_names:
+//     - Vpc
+//     - Subnet
+// ```
+type IncludeSpec struct {
+	// Set of resource names that should be generated. May not be used
+	// together with `ignore.resource_names`.
+	ResourceNames []string `json:"resource_names"`
 }
 
 // IgnoreSpec represents instructions to the ACK code generator to
@@ -59,6 +95,34 @@ type IgnoreSpec struct {
 	FieldPaths []string `json:"field_paths"`
 }
 
+// DeprecatedSpec represents instructions to the ACK code generator for
+// members that are marked `deprecated` in the AWS API model. Deprecated members
+// are dropped from the generated API types of a new API version, so that they
+// never become API surface of a new CRD, unless they are listed in
+// `keep_field_paths`. API versions listed in `released_api_versions` keep
+// their deprecated members, since dropping them would remove fields from
+// released CRDs.
+//
+// ```yaml
+// deprecated:
+//   released_api_versions:
+//     - v1alpha1
+//   keep_field_paths:
+//     - PutBucketLoggingInput.ContentMD5
+// ```
+type DeprecatedSpec struct {
+	// Set of API versions of the service controller that have already been
+	// released, e.g. "v1alpha1". Deprecated members are only dropped from
+	// API versions that are not in this list.
+	ReleasedAPIVersions []string `json:"released_api_versions"`
+	// Set of deprecated field paths to keep in a new API version. The name
+	// here should be the original name of the field as it appears in AWS SDK
+	// objects. You can refer to a field by giving its
+	// "<shape_name>.<field_name>". For example,
+	// "PutBucketLoggingInput.ContentMD5".
+	KeepFieldPaths []string `json:"keep_field_paths"`
+}
+
 type PrefixConfig struct {
 	// SpecField stores the string prefix to use for information that will be
 	// sent to AWS. Defaults to `.Spec`
@@ -81,6 +145,34 @@ func (c *Config) ResourceContainsSecret() bool {
 	return false
 }
 
+// ResourceContainsParent returns true if any resource is configured as the
+// child of a parent resource.
+func (c *Config) ResourceContainsParent() bool {
+	for _, resource := range c.Resources {
+		if resource.Parent != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// DropsDeprecatedField returns true if the supplied deprecated member of the
+// supplied shape should be dropped from the generated API types of the
+// supplied API version
+func (c *Config) DropsDeprecatedField(
+	apiVersion string,
+	shapeName string,
+	memberName string,
+) bool {
+	if c == nil {
+		return true
+	}
+	if util.InStrings(apiVersion, c.Deprecated.ReleasedAPIVersions) {
+		return false
+	}
+	return !util.InStrings(shapeName+"."+memberName, c.Deprecated.KeepFieldPaths)
+}
+
 // New returns a new Config object given a supplied
 // path to a config file
 func New(
@@ -98,5 +190,39 @@ func New(
 	if err = yaml.Unmarshal(content, &gc); err != nil {
 		return Config{}, err
 	}
+	if err = gc.validate(); err != nil {
+		return Config{}, err
+	}
 	return gc, nil
 }
+
+// validate returns an error if the generator configuration contains
+// conflicting or unknown instructions
+func (c *Config) validate() error {
+	if len(c.Include.ResourceNames) > 0 && len(c.Ignore.ResourceNames) > 0 {
+		return fmt.Errorf(
+			"include.resource_names and ignore.resource_names are " +
+				"mutually exclusive",
+		)
+	}
+	for resourceName, rConfig := range c.Resources {
+		for _, target := range rConfig.Generate {
+			if !util.InStrings(target, GenerateTargets) {
+				return fmt.Errorf(
+					"unknown generate target %s for resource %s, "+
+						"expected one of %v",
+					target, resourceName, GenerateTargets,
+				)
+			}
+		}
+		if util.InStrings(GenerateTargetController, rConfig.Generate) &&
+			!util.InStrings(GenerateTargetAPIs, rConfig.Generate) {
+			return fmt.Errorf(
+				"resource %s cannot generate the %s target without the "+
+					"%s target",
+				resourceName, GenerateTargetController, GenerateTargetAPIs,
+			)
+		}
+	}
+	return nil
+}
@@ -114,6 +114,52 @@ type CompareFieldConfig struct {
 	// NilEqualsZeroValue indicates a nil pointer and zero-value pointed-to
 	// value should be considered equal for the purposes of comparison
 	NilEqualsZeroValue bool `json:"nil_equals_zero_value"`
+	// Unordered indicates a list field should be compared as a set, ignoring
+	// the order of its elements. This is useful for lists like security
+	// group IDs that the AWS service API returns in a different order than
+	// they were supplied in.
+	Unordered bool `json:"unordered,omitempty"`
+	// KeyFields is the list of members of the struct elements of a list field
+	// that identify an element. Elements of the two lists being compared are
+	// matched by their key fields instead of their index, and the matched
+	// elements are then compared member by member. The paths of the
+	// differences identify the elements by the values of their key fields,
+	// e.g. "Spec.IPPermissions.tcp,22,22.IPRanges". The generated
+	// `equalKeys` and `elementKey` functions let custom code match and
+	// identify the elements the same way.
+	//
+	// ```yaml
+	// resources:
+	//   SecurityGroup:
+	//     fields:
+	//       IpPermissions:
+	//         compare:
+	//           key_fields:
+	//             - IpProtocol
+	//             - FromPort
+	//             - ToPort
+	// ```
+	KeyFields []string `json:"key_fields,omitempty"`
+	// Comparator is the name of the function used to decide whether two
+	// values of the field are equal, instead of the default comparison for
+	// the field's type. It is either one of the built-in comparators for
+	// string fields:
+	//
+	// * `case_insensitive` - values differing only in case are equal
+	// * `json` - values are JSON documents compared semantically
+	// * `arn_or_name` - an ARN is equal to the name of the resource it
+	//   identifies
+	// * `cidr` - values are CIDR blocks compared after normalization
+	// * `numeric_string` - values are numbers compared numerically
+	//
+	// or the name of a custom Go function in the resource's package of the
+	// controller, e.g. `equalEngineVersion`. A custom function receives the
+	// two field values and returns true if they are equal:
+	//
+	// ```go
+	// func equalEngineVersion(a, b *string) bool
+	// ```
+	Comparator string `json:"comparator,omitempty"`
 }
 
 // PrintFieldConfig instructs the code generator how to handle kubebuilder:printcolumn
@@ -130,6 +176,26 @@ type PrintFieldConfig struct {
 	Priority int `json:"priority"`
 }
 
+// DocumentationFieldConfig instructs the code generator how to document a
+// field. The text is plain text, not HTML, and is wrapped by the code
+// generator.
+//
+// ```yaml
+// resources:
+//   Repository:
+//     fields:
+//       ImageTagMutability:
+//         documentation:
+//           append: Changing the tag mutability of a repository is allowed.
+// ```
+type DocumentationFieldConfig struct {
+	// Override replaces the documentation of the field from the AWS API model
+	Override string `json:"override,omitempty"`
+	// Append is appended, as a separate paragraph, to the documentation of
+	// the field
+	Append string `json:"append,omitempty"`
+}
+
 // FieldConfig contains instructions to the code generator about how
 // to interpret the value of an Attribute and how to map it to a CRD's Spec or
 // Status field
@@ -153,6 +219,27 @@ type FieldConfig struct {
 	// default behaviour of considering a field called "Name" or
 	// "{Resource}Name" or "{Resource}Id" as the "name field" for the resource.
 	IsName bool `json:"is_name"`
+	// IdentifierPart, when non-zero, indicates the field is one part of a
+	// composite identifier for the resource and gives the 1-based position
+	// of that part. This is used for resources (usually child resources)
+	// that are addressed by more than one key, e.g. an API Gateway v2 Route
+	// is identified by both an `ApiId` and a `RouteId`:
+	//
+	// ```yaml
+	// resources:
+	//   Route:
+	//     fields:
+	//       ApiId:
+	//         identifier_part: 1
+	//       RouteId:
+	//         identifier_part: 2
+	// ```
+	//
+	// All parts need to be set before the resource can be read. Resources
+	// with more than one identifier part can't be adopted, since the
+	// `AWSIdentifiers` struct of the ACK runtime only carries a single
+	// `NameOrID`.
+	IdentifierPart int `json:"identifier_part"`
 	// IsOwnerAccountID indicates the field contains the AWS Account ID
 	// that owns the resource. This is a special field that we direct to
 	// storage in the common `Status.ACKResourceMetadata.OwnerAccountID` field.
@@ -168,6 +255,27 @@ type FieldConfig struct {
 	// IsImmutable instructs the code generator to add advisory conditions
 	// if user modifies the spec field after resource was created.
 	IsImmutable bool `json:"is_immutable"`
+	// Type overrides the Go type the code generator uses for the field.
+	// Currently the only supported type is `json`, which renders the field
+	// as a structured `apiextensionsv1.JSON` value instead of a string
+	// containing an escaped JSON document, e.g. for the SQS Queue's
+	// IAM-style policy documents:
+	//
+	// ```yaml
+	// resources:
+	//   Queue:
+	//     fields:
+	//       Policy:
+	//         is_attribute: true
+	//         type: json
+	// ```
+	//
+	// The field is marshaled to and unmarshaled from the string (or
+	// `aws.JSONValue`) member of the API's shapes and is compared
+	// semantically, ignoring key order and whitespace. Members of the API's
+	// shapes that are `aws.JSONValue` documents are rendered as JSON fields
+	// without needing this option.
+	Type string `json:"type,omitempty"`
 	// From instructs the code generator that the value of the field should
 	// be retrieved from the specified operation and member path
 	From *SourceFieldConfig `json:"from,omitempty"`
@@ -178,4 +286,29 @@ type FieldConfig struct {
 	// influence hows field are printed in `kubectl get` response. If this field
 	// is not nil, it will be added to the columns of `kubectl get`.
 	Print *PrintFieldConfig `json:"print,omitempty"`
+	// Documentation instructs the code generator how to document the field
+	// in the generated API types and the CRD's OpenAPI schema
+	Documentation *DocumentationFieldConfig `json:"documentation,omitempty"`
+	// PreserveUnknownFields instructs the code generator to mark the field
+	// with `+kubebuilder:validation:XPreserveUnknownFields` so that the
+	// Kubernetes API server does not prune fields of the value that are
+	// unknown to the CRD's OpenAPI schema
+	PreserveUnknownFields bool `json:"preserve_unknown_fields,omitempty"`
+	// Markers is a list of additional kubebuilder markers for the field,
+	// without the comment prefix, e.g.:
+	//
+	// ```yaml
+	// resources:
+	//   Repository:
+	//     fields:
+	//       RepositoryName:
+	//         markers:
+	//           - +kubebuilder:validation:MinLength=2
+	//           - +kubebuilder:validation:MaxLength=256
+	// ```
+	//
+	// Markers of nested fields are rendered on the attribute of the type
+	// definition that contains the field. The marker syntax is validated at
+	// generation time.
+	Markers []string `json:"markers,omitempty"`
 }
@@ -14,9 +14,40 @@
 package config
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/aws-controllers-k8s/code-generator/pkg/util"
 )
 
+const (
+	// ResourceScopeNamespaced is the scope of CRDs whose custom resources
+	// live in a Kubernetes Namespace
+	ResourceScopeNamespaced = "Namespaced"
+	// ResourceScopeCluster is the scope of CRDs whose custom resources are
+	// global to the Kubernetes cluster
+	ResourceScopeCluster = "Cluster"
+)
+
+const (
+	// GenerateTargetAPIs is the target of the ACK API types of a resource
+	GenerateTargetAPIs = "apis"
+	// GenerateTargetController is the target of the ACK controller code of a
+	// resource
+	GenerateTargetController = "controller"
+	// GenerateTargetCrossplane is the target of the Crossplane API types and
+	// controller code of a resource
+	GenerateTargetCrossplane = "crossplane"
+)
+
+// GenerateTargets contains all the targets that a resource may be generated
+// for
+var GenerateTargets = []string{
+	GenerateTargetAPIs,
+	GenerateTargetController,
+	GenerateTargetCrossplane,
+}
+
 // ResourceConfig represents instructions to the ACK code generator
 // for a particular CRD/resource on an AWS service API
 type ResourceConfig struct {
@@ -82,6 +113,122 @@ type ResourceConfig struct {
 	// Print contains instructions for the code generator to generate kubebuilder printcolumns
 	// marker comments.
 	Print *PrintConfig `json:"print,omitempty"`
+	// Parent contains instructions for the code generator about a resource
+	// that only exists within the context of a parent resource, e.g. an API
+	// Gateway v2 Route that lives inside an Api.
+	Parent *ParentConfig `json:"parent,omitempty"`
+	// Readiness contains instructions for the code generator to generate Go
+	// code that sets the resource's Conditions based on the value of a
+	// lifecycle status field in the resource, e.g. `DBInstanceStatus`.
+	Readiness *ReadinessConfig `json:"readiness,omitempty"`
+	// Waiters contains instructions for the code generator about which
+	// aws-sdk-go waiters to use when generating readiness and deletion
+	// checks for the resource.
+	Waiters *WaitersConfig `json:"waiters,omitempty"`
+	// Actions contains instructions for the code generator to bind a Spec
+	// field holding the desired runtime state of the resource to the API
+	// operations that transition the resource between runtime states, e.g.
+	// StartNotebookInstance and StopNotebookInstance.
+	Actions *ActionsConfig `json:"actions,omitempty"`
+	// UnwrapInput is the name of a structure member of the Create operation's
+	// Input shape whose members should be promoted to top-level Spec fields,
+	// e.g. "DistributionConfig" for the CloudFront CreateDistribution API.
+	//
+	// ```yaml
+	// resources:
+	//   Table:
+	//     unwrap_input: TableInput
+	// ```
+	//
+	// When nil, an Input shape with a single member that is a structure is
+	// unwrapped automatically. Set to the empty string to disable unwrapping.
+	// Field paths in the resource's `fields` and `compare` configuration may
+	// refer to promoted fields either directly ("Comment") or through the
+	// wrapper member ("DistributionConfig.Comment").
+	UnwrapInput *string `json:"unwrap_input,omitempty"`
+	// Scope is the scope of the resource's CRD, either "Namespaced" (the
+	// default) or "Cluster". Resources that are global to an AWS account,
+	// e.g. the IAM account password policy, are best represented by
+	// cluster-scoped CRDs.
+	//
+	// ```yaml
+	// resources:
+	//   AccountPasswordPolicy:
+	//     scope: Cluster
+	// ```
+	Scope string `json:"scope,omitempty"`
+	// Singleton indicates that there is exactly one of the resource per AWS
+	// account and region, e.g. the ECR registry policy or the S3 account
+	// public access block. A singleton resource has no identifier field. Its
+	// `Put` operation, e.g. PutRegistryPolicy, is used to both create and
+	// update the resource and an `AccountId` member of the API operations'
+	// Input shapes is set to the AWS account ID of the resource manager
+	// instead of being a field of the resource. A singleton resource must
+	// have the `Cluster` scope.
+	//
+	// ```yaml
+	// resources:
+	//   PublicAccessBlock:
+	//     scope: Cluster
+	//     singleton: true
+	// ```
+	Singleton bool `json:"singleton,omitempty"`
+	// Generate is the list of targets that the resource is generated for,
+	// any of "apis", "controller" and "crossplane". The resource is generated
+	// for all targets when the list is empty. Generating the "controller"
+	// target requires the "apis" target.
+	//
+	// ```yaml
+	// resources:
+	//   Vpc:
+	//     generate:
+	//       - apis
+	//       - crossplane
+	// ```
+	Generate []string `json:"generate,omitempty"`
+	// Categories is the list of categories the CRD belongs to, e.g.
+	// "aws-all", so that `kubectl get aws-all` lists the custom resources of
+	// all CRDs in the category
+	Categories []string `json:"categories,omitempty"`
+	// StorageVersion marks the API version being generated as the storage
+	// version of the CRD
+	StorageVersion bool `json:"storage_version,omitempty"`
+	// Metadata contains the labels and annotations of the
+	// CustomResourceDefinition object itself, which are set by a kustomize
+	// patch in config/crd/patches
+	Metadata *CRDMetadataConfig `json:"metadata,omitempty"`
+	// Markers is a list of additional kubebuilder markers for the CRD's Go
+	// type, without the comment prefix, e.g.:
+	//
+	// ```yaml
+	// resources:
+	//   Repository:
+	//     markers:
+	//       - +kubebuilder:deprecatedversion:warning="use v1 instead"
+	// ```
+	//
+	// The marker syntax is validated at generation time.
+	Markers []string `json:"markers,omitempty"`
+}
+
+// CRDMetadataConfig contains the labels and annotations of a
+// CustomResourceDefinition object
+//
+// ```yaml
+// resources:
+//   Repository:
+//     metadata:
+//       labels:
+//         app.kubernetes.io/part-of: ecr-controller
+//       annotations:
+//         example.com/owner: ecr-team
+// ```
+type CRDMetadataConfig struct {
+	// Labels is a map of the labels of the CustomResourceDefinition
+	Labels map[string]string `json:"labels,omitempty"`
+	// Annotations is a map of the annotations of the
+	// CustomResourceDefinition
+	Annotations map[string]string `json:"annotations,omitempty"`
 }
 
 // HooksConfig instructs the code generator how to inject custom callback hooks
@@ -118,7 +265,20 @@ type HooksConfig struct {
 // CompareConfig informs instruct the code generator on how to compare two different
 // two objects of the same type
 type CompareConfig struct {
-	// Ignore is a list of field paths to ignore when comparing two objects
+	// Ignore is a list of field paths to ignore when comparing two objects.
+	// Paths may be nested and the "Spec." prefix is optional. `[*]` matches
+	// any element of a list or value of a map, e.g.:
+	//
+	// ```yaml
+	// resources:
+	//   Function:
+	//     compare:
+	//       ignore:
+	//         - Spec.Code.S3ObjectVersion
+	//         - Spec.FileSystemConfigs[*].LocalMountPath
+	// ```
+	//
+	// Every path must be the path of a Spec field of the resource.
 	Ignore []string `json:"ignore"`
 }
 
@@ -278,6 +438,162 @@ type PrintConfig struct {
 	OrderBy string `json:"order_by"`
 }
 
+// ParentConfig instructs the code generator that a resource is a child of
+// another resource managed by the same service controller.
+//
+// ```yaml
+// resources:
+//   Route:
+//     parent:
+//       resource: Api
+//       field: ApiId
+// ```
+//
+// For child resources, the generated code resolves the parent custom
+// resource (either from a reference or from the value of the parent
+// identifier field), waits for the parent to be synced before creating the
+// child, and adds an owner reference to the parent on the child. The
+// generated code for the parent resource refuses to delete the parent while
+// any child custom resource refers to it.
+type ParentConfig struct {
+	// Resource is the name of the parent resource, e.g. "Api"
+	Resource string `json:"resource"`
+	// Field is the name of the field in the child resource that carries the
+	// parent resource's identifier, e.g. "ApiId"
+	Field string `json:"field"`
+	// ParentField is the name of the field in the parent resource that
+	// contains the identifier copied into the child's Field. If empty, the
+	// parent field is assumed to have the same name as Field.
+	ParentField string `json:"parent_field,omitempty"`
+}
+
+// ReadinessConfig instructs the code generator how to interpret the values
+// of a resource's lifecycle status field.
+//
+// ```yaml
+// resources:
+//   DBInstance:
+//     readiness:
+//       path: DBInstanceStatus
+//       ready:
+//         - available
+//       in_progress:
+//         - creating
+//         - modifying
+//         - backing-up
+//       failed:
+//         - failed
+//         - incompatible-parameters
+//       deleting:
+//         - deleting
+// ```
+//
+// The generated `updateConditions` method only sets the ResourceSynced
+// condition to True when the status field has one of the Ready values. While
+// the field has one of the InProgress values, the resource is requeued with
+// backoff. When the field has one of the Failed values, a Terminal condition
+// is set on the resource. When the field has one of the Deleting values, the
+// generated `sdkDelete` method does not call the Delete API again and waits
+// for the resource to disappear.
+type ReadinessConfig struct {
+	// Path is the field path, within the resource's Spec or Status, of the
+	// lifecycle status field, e.g. "DBInstanceStatus" or "State.Name"
+	Path string `json:"path"`
+	// Ready is the list of status values meaning the resource is available
+	Ready []string `json:"ready"`
+	// InProgress is the list of status values meaning the resource is
+	// transitioning between states
+	InProgress []string `json:"in_progress,omitempty"`
+	// Failed is the list of status values meaning the resource is in a state
+	// it can not recover from without user intervention
+	Failed []string `json:"failed,omitempty"`
+	// Deleting is the list of status values meaning the resource is being
+	// deleted by the backend AWS service API
+	Deleting []string `json:"deleting,omitempty"`
+}
+
+// WaitersConfig instructs the code generator which of the waiters defined in
+// the aws-sdk-go `waiters-2.json` model file to use for a resource.
+//
+// By default, the code generator looks for waiters that poll the resource's
+// read operation and uses their acceptors to generate readiness and
+// deletion-completion checks. When more than one waiter polls the read
+// operation, the waiters can be named explicitly:
+//
+// ```yaml
+// resources:
+//   Instance:
+//     waiters:
+//       ready: InstanceRunning
+//       deleted: InstanceTerminated
+// ```
+//
+// A `readiness` block always takes precedence over the ready waiter.
+type WaitersConfig struct {
+	// Ready is the name of the waiter whose acceptors describe when the
+	// resource is available, e.g. "DBInstanceAvailable"
+	Ready string `json:"ready,omitempty"`
+	// Deleted is the name of the waiter whose acceptors describe when the
+	// resource has been deleted, e.g. "DBInstanceDeleted"
+	Deleted string `json:"deleted,omitempty"`
+	// Ignore instructs the code generator to not use any waiters for the
+	// resource
+	Ignore bool `json:"ignore"`
+}
+
+// ActionsConfig instructs the code generator how to place a resource into a
+// desired runtime state using API operations that are neither Create, Update
+// nor Delete operations.
+//
+// ```yaml
+// resources:
+//   NotebookInstance:
+//     readiness:
+//       path: NotebookInstanceStatus
+//       ready:
+//         - InService
+//         - Stopped
+//       in_progress:
+//         - Pending
+//         - Updating
+//         - Stopping
+//     actions:
+//       field: DesiredState
+//       states:
+//         - value: InService
+//           operation: StartNotebookInstance
+//         - value: Stopped
+//           operation: StopNotebookInstance
+// ```
+//
+// The code generator adds a string Spec field with the supplied name to the
+// resource. The lifecycle status field described by the resource's
+// `readiness` block is compared with the desired state and, when they differ,
+// the generated `sdkUpdate` method calls the operation that transitions the
+// resource into the desired state. Nothing is done while the lifecycle status
+// field has one of the readiness InProgress values.
+type ActionsConfig struct {
+	// Field is the name of the Spec field containing the desired runtime
+	// state of the resource, e.g. "DesiredState"
+	Field string `json:"field"`
+	// States is the list of runtime states the resource can be placed into
+	States []*ActionStateConfig `json:"states"`
+}
+
+// ActionStateConfig describes a single runtime state of a resource and the
+// API operation that transitions the resource into that state
+type ActionStateConfig struct {
+	// Value is the value of the desired state Spec field, e.g. "Stopped"
+	Value string `json:"value"`
+	// Operation is the ID of the API operation that transitions the resource
+	// into this state, e.g. "StopNotebookInstance"
+	Operation string `json:"operation"`
+	// Observed is the list of values of the resource's lifecycle status field
+	// meaning the resource is in this state. If empty, the resource is in
+	// this state when the lifecycle status field is equal to Value.
+	Observed []string `json:"observed,omitempty"`
+}
+
 // ReconcileConfig describes options for controlling the reconciliation
 // logic for a particular resource.
 type ReconcileConfig struct {
@@ -380,7 +696,8 @@ func (c *Config) GetCompareIgnoredFields(resName string) []string {
 }
 
 // IsIgnoredResource returns true if Operation Name is configured to be ignored
-// in generator config for the AWS service
+// in generator config for the AWS service, is not in the configured allow-list
+// of resources or is not generated for the generator's target
 func (c *Config) IsIgnoredResource(resourceName string) bool {
 	if resourceName == "" {
 		return true
@@ -388,9 +705,30 @@ func (c *Config) IsIgnoredResource(resourceName string) bool {
 	if c == nil {
 		return false
 	}
+	if len(c.Include.ResourceNames) > 0 &&
+		!util.InStrings(resourceName, c.Include.ResourceNames) {
+		return true
+	}
+	if c.GenerateTarget != "" &&
+		!c.ResourceGeneratesTarget(resourceName, c.GenerateTarget) {
+		return true
+	}
 	return util.InStrings(resourceName, c.Ignore.ResourceNames)
 }
 
+// ResourceGeneratesTarget returns true if the supplied resource is generated
+// for the supplied target, e.g. "controller"
+func (c *Config) ResourceGeneratesTarget(resourceName string, target string) bool {
+	if c == nil {
+		return true
+	}
+	rConfig, ok := c.Resources[resourceName]
+	if !ok || len(rConfig.Generate) == 0 {
+		return true
+	}
+	return util.InStrings(target, rConfig.Generate)
+}
+
 // ResourceInputFieldRename returns the renamed field for a Resource, a
 // supplied Operation ID and original field name and whether or not a renamed
 // override field name was found
@@ -477,3 +815,175 @@ func (c *Config) GetResourcePrintAddAgeColumn(resourceName string) bool {
 	}
 	return false
 }
+
+// ResourceParent returns the ParentConfig for the supplied resource or nil
+// if the resource does not have a parent
+func (c *Config) ResourceParent(resourceName string) *ParentConfig {
+	if c == nil {
+		return nil
+	}
+	rConfig, ok := c.Resources[resourceName]
+	if !ok {
+		return nil
+	}
+	return rConfig.Parent
+}
+
+// ResourceReadiness returns the ReadinessConfig for the supplied resource or
+// nil if the resource has no lifecycle status field configured
+func (c *Config) ResourceReadiness(resourceName string) *ReadinessConfig {
+	if c == nil {
+		return nil
+	}
+	rConfig, ok := c.Resources[resourceName]
+	if !ok {
+		return nil
+	}
+	return rConfig.Readiness
+}
+
+// ResourceWaiters returns the WaitersConfig for the supplied resource or nil
+// if the resource has no waiters configured
+func (c *Config) ResourceWaiters(resourceName string) *WaitersConfig {
+	if c == nil {
+		return nil
+	}
+	rConfig, ok := c.Resources[resourceName]
+	if !ok {
+		return nil
+	}
+	return rConfig.Waiters
+}
+
+// ResourceActions returns the ActionsConfig for the supplied resource or nil
+// if the resource has no actions configured
+func (c *Config) ResourceActions(resourceName string) *ActionsConfig {
+	if c == nil {
+		return nil
+	}
+	rConfig, ok := c.Resources[resourceName]
+	if !ok {
+		return nil
+	}
+	return rConfig.Actions
+}
+
+// ResourceUnwrapInput returns the name of the Create operation's Input shape
+// member configured to be unwrapped for the supplied resource and true, or
+// the empty string and false if the resource has no `unwrap_input`
+// configuration
+func (c *Config) ResourceUnwrapInput(resourceName string) (string, bool) {
+	if c == nil {
+		return "", false
+	}
+	rConfig, ok := c.Resources[resourceName]
+	if !ok || rConfig.UnwrapInput == nil {
+		return "", false
+	}
+	return *rConfig.UnwrapInput, true
+}
+
+// TrimResourceFieldPathPrefix rewrites the field paths in the `fields` and
+// `compare.ignore` configuration of the supplied resource that start with
+// the supplied prefix, e.g. "DistributionConfig.Comment" becomes "Comment"
+// for the prefix "DistributionConfig". Generation fails if a rewritten field
+// path collides with another field path of the same configuration, since
+// both would then refer to the same field.
+func (c *Config) TrimResourceFieldPathPrefix(resourceName string, prefix string) {
+	if c == nil {
+		return
+	}
+	rConfig, ok := c.Resources[resourceName]
+	if !ok {
+		return
+	}
+	prefix += "."
+	for fieldPath, fieldConfig := range rConfig.Fields {
+		if !strings.HasPrefix(fieldPath, prefix) {
+			continue
+		}
+		trimmed := strings.TrimPrefix(fieldPath, prefix)
+		if _, found := rConfig.Fields[trimmed]; found {
+			panicTrimmedFieldPathCollision(resourceName, "fields", fieldPath, trimmed)
+		}
+		rConfig.Fields[trimmed] = fieldConfig
+		delete(rConfig.Fields, fieldPath)
+	}
+	if rConfig.Compare == nil {
+		return
+	}
+	// Paths to ignore may start with "Spec.", so compare them without it
+	seen := map[string]string{}
+	trimmedPaths := make([]string, len(rConfig.Compare.Ignore))
+	for x, fieldPath := range rConfig.Compare.Ignore {
+		specPrefix := ""
+		path := fieldPath
+		if strings.HasPrefix(path, "Spec.") {
+			specPrefix = "Spec."
+			path = strings.TrimPrefix(path, specPrefix)
+		}
+		path = strings.TrimPrefix(path, prefix)
+		if other, found := seen[path]; found {
+			panicTrimmedFieldPathCollision(resourceName, "compare.ignore", fieldPath, other)
+		}
+		seen[path] = fieldPath
+		trimmedPaths[x] = specPrefix + path
+	}
+	rConfig.Compare.Ignore = trimmedPaths
+}
+
+// panicTrimmedFieldPathCollision fails generation because the supplied field
+// path of the supplied resource's configuration refers to the same field as
+// another field path once the unwrapped Input member is trimmed from it
+func panicTrimmedFieldPathCollision(
+	resourceName string,
+	configName string,
+	fieldPath string,
+	otherFieldPath string,
+) {
+	msg := fmt.Sprintf(
+		"GENERATION FAILURE! %s field paths %s and %s of resource %s "+
+			"refer to the same field once the unwrapped Input member is "+
+			"removed from the path. Remove one of them.",
+		configName, fieldPath, otherFieldPath, resourceName,
+	)
+	panic(msg)
+}
+
+// ResourceCategories returns the categories of the supplied resource's CRD
+func (c *Config) ResourceCategories(resourceName string) []string {
+	if c == nil {
+		return nil
+	}
+	rConfig, ok := c.Resources[resourceName]
+	if !ok {
+		return nil
+	}
+	return rConfig.Categories
+}
+
+// ResourceScope returns the configured scope of the supplied resource's CRD,
+// or "Namespaced" if the resource has no `scope` configuration
+func (c *Config) ResourceScope(resourceName string) string {
+	if c == nil {
+		return ResourceScopeNamespaced
+	}
+	rConfig, ok := c.Resources[resourceName]
+	if !ok || rConfig.Scope == "" {
+		return ResourceScopeNamespaced
+	}
+	return rConfig.Scope
+}
+
+// ResourceIsSingleton returns true if the supplied resource is configured as
+// a singleton of which there is exactly one per AWS account and region
+func (c *Config) ResourceIsSingleton(resourceName string) bool {
+	if c == nil {
+		return false
+	}
+	rConfig, ok := c.Resources[resourceName]
+	if !ok {
+		return false
+	}
+	return rConfig.Singleton
+}
@@ -23,4 +23,5 @@ var DefaultConfig = config.Config{
 	},
 	IncludeACKMetadata:             false,
 	SetManyOutputNotFoundErrReturn: "return cr",
+	GenerateTarget:                 config.GenerateTargetCrossplane,
 }
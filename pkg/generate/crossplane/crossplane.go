@@ -16,7 +16,6 @@ package crossplane
 import (
 	"path/filepath"
 	"strings"
-	ttpl "text/template"
 
 	"github.com/aws-controllers-k8s/code-generator/pkg/generate"
 	"github.com/aws-controllers-k8s/code-generator/pkg/generate/code"
@@ -44,54 +43,7 @@ var (
 		"crossplane/pkg/sdk_find_get_attributes.go.tpl",
 	}
 	copyPaths = []string{}
-	funcMap   = ttpl.FuncMap{
-		"ToLower": strings.ToLower,
-		"ResourceExceptionCode": func(r *ackmodel.CRD, httpStatusCode int) string {
-			return r.ExceptionCode(httpStatusCode)
-		},
-		"GoCodeSetExceptionMessageCheck": func(r *ackmodel.CRD, httpStatusCode int) string {
-			return code.CheckExceptionMessage(r.Config(), r, httpStatusCode)
-		},
-		"GoCodeSetReadOneOutput": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int, performSpecUpdate bool) string {
-			return code.SetResource(r.Config(), r, ackmodel.OpTypeGet, sourceVarName, targetVarName, indentLevel, performSpecUpdate)
-		},
-		"GoCodeSetReadOneInput": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int) string {
-			return code.SetSDK(r.Config(), r, ackmodel.OpTypeGet, sourceVarName, targetVarName, indentLevel)
-		},
-		"GoCodeSetReadManyOutput": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int, performSpecUpdate bool) string {
-			return code.SetResource(r.Config(), r, ackmodel.OpTypeList, sourceVarName, targetVarName, indentLevel, performSpecUpdate)
-		},
-		"ListMemberNameInReadManyOutput": func(r *ackmodel.CRD) string {
-			return code.ListMemberNameInReadManyOutput(r)
-		},
-		"GoCodeSetReadManyInput": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int) string {
-			return code.SetSDK(r.Config(), r, ackmodel.OpTypeList, sourceVarName, targetVarName, indentLevel)
-		},
-		"GoCodeGetAttributesSetInput": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int) string {
-			return code.SetSDKGetAttributes(r.Config(), r, sourceVarName, targetVarName, indentLevel)
-		},
-		"GoCodeSetAttributesSetInput": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int) string {
-			return code.SetSDKSetAttributes(r.Config(), r, sourceVarName, targetVarName, indentLevel)
-		},
-		"GoCodeGetAttributesSetOutput": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int) string {
-			return code.SetResourceGetAttributes(r.Config(), r, sourceVarName, targetVarName, indentLevel)
-		},
-		"GoCodeSetCreateOutput": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int, performSpecUpdate bool) string {
-			return code.SetResource(r.Config(), r, ackmodel.OpTypeCreate, sourceVarName, targetVarName, indentLevel, performSpecUpdate)
-		},
-		"GoCodeSetCreateInput": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int) string {
-			return code.SetSDK(r.Config(), r, ackmodel.OpTypeCreate, sourceVarName, targetVarName, indentLevel)
-		},
-		"GoCodeSetUpdateInput": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int) string {
-			return code.SetSDK(r.Config(), r, ackmodel.OpTypeUpdate, sourceVarName, targetVarName, indentLevel)
-		},
-		"GoCodeSetDeleteInput": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int) string {
-			return code.SetSDK(r.Config(), r, ackmodel.OpTypeDelete, sourceVarName, targetVarName, indentLevel)
-		},
-		"Empty": func(subject string) bool {
-			return strings.TrimSpace(subject) == ""
-		},
-	}
+	funcMap   = code.FuncMap()
 )
 
 // templateAPIVars contains template variables for templates that output Go
@@ -100,6 +52,9 @@ type templateAPIVars struct {
 	templateset.MetaVars
 	EnumDefs []*ackmodel.EnumDef
 	TypeDefs []*ackmodel.TypeDef
+	// TypeImports is a map, keyed by an import string, with the map value
+	// being an optional alias, of additional packages the TypeDefs need
+	TypeImports map[string]string
 }
 
 // templateCRDVars contains template variables for the template that outputs Go
@@ -135,13 +90,17 @@ func Crossplane(
 		funcMap,
 	)
 
-	metaVars := g.MetaVars()
+	metaVars, err := g.MetaVars()
+	if err != nil {
+		return nil, err
+	}
 
 	// First add all the CRDs and API types
 	apiVars := &templateAPIVars{
 		metaVars,
 		enumDefs,
 		typeDefs,
+		ackmodel.TypeDefImports(typeDefs),
 	}
 	for _, path := range apisGenericTemplatesPaths {
 		outPath := filepath.Join(
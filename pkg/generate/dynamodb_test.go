@@ -101,4 +101,26 @@ func TestDynamoDB_Table(t *testing.T) {
 		"Tags",
 	}
 	assert.Equal(expSpecFieldCamel, attrCamelNames(specFields))
+
+	// The DynamoDB waiters-2.json model file has a TableExists waiter that
+	// succeeds once the DescribeTable Output shape's Table.TableStatus field
+	// is "ACTIVE" and a TableNotExists waiter that succeeds once DescribeTable
+	// returns a ResourceNotFoundException.
+	readyWaiter := crd.ReadyWaiter()
+	require.NotNil(readyWaiter)
+	assert.Equal("TableExists", readyWaiter.Name)
+
+	deletionWaiter := crd.DeletionWaiter()
+	require.NotNil(deletionWaiter)
+	assert.Equal("TableNotExists", deletionWaiter.Name)
+	assert.Empty(crd.DeletedLifecycleValues())
+
+	// There is no readiness block in the generator config, so the readiness
+	// checks are derived from the TableExists waiter's acceptors
+	readiness := crd.Readiness()
+	require.NotNil(readiness)
+	assert.Equal("TableStatus", readiness.Path)
+	assert.Equal([]string{"ACTIVE"}, readiness.Ready)
+	assert.Empty(readiness.InProgress)
+	assert.Empty(readiness.Failed)
 }
@@ -19,6 +19,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/aws-controllers-k8s/code-generator/pkg/generate"
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/generate/config"
+	cpgenerate "github.com/aws-controllers-k8s/code-generator/pkg/generate/crossplane"
 	"github.com/aws-controllers-k8s/code-generator/pkg/testutil"
 )
 
@@ -101,3 +104,60 @@ func TestEC2_LaunchTemplate(t *testing.T) {
 	assert.Nil(crd.Ops.ReadOne)
 	assert.NotNil(crd.Ops.ReadMany)
 }
+
+func TestEC2_IncludedResources(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewGeneratorForService(t, "ec2")
+
+	crds, err := g.GetCRDs()
+	require.Nil(err)
+
+	// Only the resources in the generator.yaml's include.resource_names
+	// allow-list are generated, out of the many EC2 Create operations
+	crdNames := []string{}
+	for _, crd := range crds {
+		crdNames = append(crdNames, crd.Names.Camel)
+	}
+	assert.Equal([]string{"Fleet", "LaunchTemplate", "Subnet", "VPC"}, crdNames)
+	assert.Len(g.SDKAPI.CRDNames(g.GetConfig()), 4)
+
+	// The Subnet resource is generated for the API types and Crossplane
+	// targets only
+	subnet := getCRDByName("Subnet", crds)
+	require.NotNil(subnet)
+	assert.True(subnet.GeneratesTarget(ackgenconfig.GenerateTargetAPIs))
+	assert.False(subnet.GeneratesTarget(ackgenconfig.GenerateTargetController))
+	assert.True(subnet.GeneratesTarget(ackgenconfig.GenerateTargetCrossplane))
+
+	vpc := getCRDByName("Vpc", crds)
+	require.NotNil(vpc)
+	assert.True(vpc.GeneratesTarget(ackgenconfig.GenerateTargetController))
+}
+
+func TestEC2_GenerateTargets(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	sdkAPI := testutil.NewGeneratorForService(t, "ec2").SDKAPI
+	cfgPath := "testdata/models/apis/ec2/0000-00-00/generator.yaml"
+
+	// The Subnet resource is not generated for a target that is missing from
+	// its generate configuration
+	cfg, err := ackgenconfig.New(cfgPath, cpgenerate.DefaultConfig)
+	require.Nil(err)
+	cfg.Resources["Subnet"] = ackgenconfig.ResourceConfig{
+		Generate: []string{ackgenconfig.GenerateTargetAPIs},
+	}
+	assert.True(cfg.IsIgnoredResource("Subnet"))
+	assert.False(cfg.IsIgnoredResource("Vpc"))
+	// Resources outside of the allow-list are ignored
+	assert.True(cfg.IsIgnoredResource("Instance"))
+
+	g, err := generate.New(sdkAPI, "v1alpha1", cfgPath, cpgenerate.DefaultConfig)
+	require.Nil(err)
+	crds, err := g.GetCRDs()
+	require.Nil(err)
+	assert.NotNil(getCRDByName("Subnet", crds))
+}
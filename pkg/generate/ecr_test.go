@@ -49,6 +49,51 @@ func TestECRRepository(t *testing.T) {
 	assert.Nil(crd.Ops.ReadOne)
 	assert.NotNil(crd.Ops.ReadMany)
 
+	// Resources are namespaced and have an identifier unless configured
+	// otherwise
+	assert.Equal("Namespaced", crd.Scope())
+	assert.False(crd.IsClusterScoped())
+	assert.False(crd.IsSingleton())
+	assert.Equal(
+		"+kubebuilder:resource:categories=aws-all;ecr", crd.ResourceMarker(),
+	)
+
+	assert.Equal([]string{"aws-all", "ecr"}, crd.Categories())
+
+	// Markers configured for the resource are rendered after the
+	// storageversion marker
+	assert.Equal(
+		[]string{
+			"+kubebuilder:storageversion",
+			`+kubebuilder:deprecatedversion:warning="use ecr.services.k8s.aws/v1 instead"`,
+		},
+		crd.Markers(),
+	)
+
+	// controller-gen has no marker for the labels and annotations of the CRD
+	metadata := crd.CRDMetadata()
+	require.NotNil(metadata)
+	assert.Equal(
+		map[string]string{"app.kubernetes.io/part-of": "ecr-controller"},
+		metadata.Labels,
+	)
+	assert.Equal(
+		map[string]string{"example.com/owner": "ecr-team"},
+		metadata.Annotations,
+	)
+	assert.Equal(
+		[]string{
+			"+kubebuilder:validation:MinLength=2",
+			"+kubebuilder:validation:MaxLength=256",
+		},
+		crd.SpecFields["RepositoryName"].Markers(),
+	)
+	assert.Equal(
+		[]string{"+kubebuilder:validation:XPreserveUnknownFields"},
+		crd.SpecFields["ImageScanningConfiguration"].Markers(),
+	)
+	assert.Empty(crd.SpecFields["ImageTagMutability"].Markers())
+
 	// There is no update operation (you need to call various SetXXX operations
 	// on the Repository's components
 	assert.Nil(crd.Ops.Update)
@@ -129,4 +174,38 @@ func TestECRRepository(t *testing.T) {
 		"RepositoryURI",
 	}
 	assert.Equal(expStatusFieldCamel, attrCamelNames(statusFields))
+
+	// The API model documentation is converted to wrapped plain text with
+	// code quoted by backticks, and may be appended to or overridden by the
+	// field's documentation configuration
+	expImageTagMutabilityDoc := `// The tag mutability setting for the repository. If this parameter is omitted,
+	// the default setting of ` + "`MUTABLE`" + ` will be used which will allow image tags to
+	// be overwritten. If ` + "`IMMUTABLE`" + ` is specified, all image tags within the
+	// repository will be immutable which will prevent them from being overwritten.
+	//
+	// Changing the tag mutability of a repository is allowed.`
+	assert.Equal(expImageTagMutabilityDoc, specFields["ImageTagMutability"].Documentation())
+	assert.Equal(
+		"// The metadata that you apply to the `Repository`.",
+		specFields["Tags"].Documentation(),
+	)
+}
+
+func TestECRRepository_NestedFieldMarkers(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewGeneratorForService(t, "ecr")
+
+	tdefs, err := g.GetTypeDefs()
+	require.Nil(err)
+
+	// Markers configured for a nested field are rendered on the attribute of
+	// the type definition that contains it
+	tdef := getTypeDefByName("ImageScanningConfiguration", tdefs)
+	require.NotNil(tdef)
+
+	attr, found := tdef.Attrs["ScanOnPush"]
+	require.True(found)
+	assert.Equal([]string{"+kubebuilder:default=false"}, attr.Markers)
 }
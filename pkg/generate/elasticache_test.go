@@ -283,4 +283,67 @@ func TestElasticache_ValidateAuthTokenIsSecret(t *testing.T) {
 	assert.Equal("[]*ackv1alpha1.SecretKeyReference", crd.SpecFields["Passwords"].GoType)
 	assert.Equal("SecretKeyReference", crd.SpecFields["Passwords"].GoTypeElem)
 	assert.Equal("[]*ackv1alpha1.SecretKeyReference", crd.SpecFields["Passwords"].GoTypeWithPkgName)
-}
\ No newline at end of file
+}
+func TestElasticache_ReplicationGroup_ListCompareConfig(t *testing.T) {
+	require := require.New(t)
+
+	g := testutil.NewGeneratorForService(t, "elasticache")
+	crds, err := g.GetCRDs()
+
+	require.Nil(err)
+
+	crd := getCRDByName("ReplicationGroup", crds)
+	require.NotNil(crd)
+
+	assert := assert.New(t)
+	assert.True(crd.HasUnorderedListFields())
+
+	assert.True(crd.SpecFields["SecurityGroupIds"].IsUnorderedList())
+	assert.Nil(crd.SpecFields["SecurityGroupIds"].ListKeyFields())
+	assert.False(crd.SpecFields["Tags"].IsUnorderedList())
+	assert.Equal([]string{"Key"}, crd.SpecFields["Tags"].ListKeyFields())
+	assert.False(crd.SpecFields["SnapshotArns"].IsUnorderedList())
+
+	crd = getCRDByName("User", crds)
+	require.NotNil(crd)
+
+	assert.False(crd.HasUnorderedListFields())
+}
+
+func TestElasticache_ReplicationGroup_CompareIgnoredPaths(t *testing.T) {
+	require := require.New(t)
+
+	g := testutil.NewGeneratorForService(t, "elasticache")
+	crds, err := g.GetCRDs()
+
+	require.Nil(err)
+
+	crd := getCRDByName("ReplicationGroup", crds)
+	require.NotNil(crd)
+
+	assert := assert.New(t)
+	assert.Equal(
+		map[string]bool{
+			"SnapshotWindow":                       true,
+			"NodeGroupConfiguration..ReplicaCount": true,
+			"NodeGroupConfiguration..Slots":        true,
+			"LogDeliveryConfigurations..DestinationDetails.CloudWatchLogsDetails": true,
+		},
+		crd.CompareIgnoredPaths(),
+	)
+	assert.True(crd.IsCompareIgnoredPath("Spec.NodeGroupConfiguration[*].Slots"))
+	assert.True(crd.IsCompareIgnoredPath("NodeGroupConfiguration..ReplicaCount"))
+	assert.False(crd.IsCompareIgnoredPath("NodeGroupConfiguration..NodeGroupID"))
+	assert.False(crd.IsCompareIgnoredPath("LogDeliveryConfigurations..DestinationDetails"))
+
+	// Ignore paths that are not Spec fields fail generation
+	for _, ignorePath := range []string{
+		"Spec.NodeGroupConfiguration[*].Nope",
+		"Spec.Events",
+	} {
+		g := testutil.NewGeneratorForService(t, "elasticache")
+		rConfig := g.GetConfig().Resources["ReplicationGroup"]
+		rConfig.Compare.Ignore = append(rConfig.Compare.Ignore, ignorePath)
+		assert.Panics(func() { g.GetCRDs() }, ignorePath)
+	}
+}
@@ -66,17 +66,15 @@ func TestEnumDefs(t *testing.T) {
 		{
 			"original same as clean value",
 			"ecr",
-			"ScanStatus",
-			"scanStatus",
+			"ImageTagMutability",
+			"imageTagMutability",
 			[]string{
-				"COMPLETE",
-				"FAILED",
-				"IN_PROGRESS",
+				"IMMUTABLE",
+				"MUTABLE",
 			},
 			[]string{
-				"COMPLETE",
-				"FAILED",
-				"IN_PROGRESS",
+				"IMMUTABLE",
+				"MUTABLE",
 			},
 		},
 		{
@@ -110,3 +108,62 @@ func TestEnumDefs(t *testing.T) {
 		assert.Equal(test.expValuesClean, sortedCleanValues(edef.Values))
 	}
 }
+
+func TestEnumDefs_PruneUnused(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewGeneratorForService(t, "ecr")
+
+	edefs, err := g.GetEnumDefs()
+	require.Nil(err)
+
+	// The ScanStatus enum is only used by image scan findings, which are not
+	// part of any ECR resource
+	assert.Nil(getEnumDefByName("ScanStatus", edefs))
+
+	crd := testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+
+	field := crd.SpecFields["ImageTagMutability"]
+	require.NotNil(field)
+	require.NotNil(field.EnumDef)
+	assert.Equal("ImageTagMutability", field.EnumDef.Names.Camel)
+	assert.Equal("*string", field.GoType)
+	assert.Equal(
+		"+kubebuilder:validation:Enum=MUTABLE;IMMUTABLE",
+		field.EnumDef.ValidationMarker(),
+	)
+
+	// Fields that aren't constrained to enum values don't reference an enum
+	assert.Nil(crd.SpecFields["RepositoryName"].EnumDef)
+}
+
+func TestEnumDefs_SpecTypeDefsOnly(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewGeneratorForService(t, "dynamodb")
+
+	// KeySchemaElement is reachable from the Table's Spec.KeySchema field,
+	// so its KeyType attribute is validated against the KeyType enum
+	tdef := testutil.GetTypeDefByName(t, g, "KeySchemaElement")
+	require.NotNil(tdef)
+	attr := tdef.Attrs["KeyType"]
+	require.NotNil(attr)
+	require.NotNil(attr.EnumDef)
+	assert.Equal("KeyType", attr.EnumDef.Names.Camel)
+
+	// SSEDescription is only reachable from the Table's Status, which the
+	// controller must be able to write whatever value the service returns
+	tdef = testutil.GetTypeDefByName(t, g, "SSEDescription")
+	require.NotNil(tdef)
+	attr = tdef.Attrs["SSEType"]
+	require.NotNil(attr)
+	assert.Nil(attr.EnumDef)
+
+	// The SSEType enum is still generated since a Status field uses it
+	edefs, err := g.GetEnumDefs()
+	require.Nil(err)
+	assert.NotNil(getEnumDefByName("SSEType", edefs))
+}
@@ -23,6 +23,7 @@ import (
 	ackmodel "github.com/aws-controllers-k8s/code-generator/pkg/model"
 	"github.com/aws-controllers-k8s/code-generator/pkg/names"
 	"github.com/aws-controllers-k8s/code-generator/pkg/util"
+	awssdkmodel "github.com/aws/aws-sdk-go/private/model/api"
 )
 
 // Generator contains the ACK model for the generator to process and apply
@@ -42,7 +43,11 @@ type Generator struct {
 
 // MetaVars returns a MetaVars struct populated with metadata about the AWS
 // service API
-func (g *Generator) MetaVars() templateset.MetaVars {
+func (g *Generator) MetaVars() (templateset.MetaVars, error) {
+	crds, err := g.GetCRDs()
+	if err != nil {
+		return templateset.MetaVars{}, err
+	}
 	return templateset.MetaVars{
 		ServiceAlias:            g.serviceAlias,
 		ServiceID:               g.SDKAPI.ServiceID(),
@@ -50,15 +55,16 @@ func (g *Generator) MetaVars() templateset.MetaVars {
 		APIGroup:                g.SDKAPI.APIGroup(),
 		APIVersion:              g.apiVersion,
 		SDKAPIInterfaceTypeName: g.SDKAPI.SDKAPIInterfaceTypeName(),
-		CRDNames:                g.crdNames(),
-	}
+		CRDNames:                crdNames(crds),
+		NamespacedCRDNames:      crdNamesWithScope(crds, ackgenconfig.ResourceScopeNamespaced),
+		ClusterScopedCRDNames:   crdNamesWithScope(crds, ackgenconfig.ResourceScopeCluster),
+	}, nil
 }
 
 // crdNames returns all crd names lowercased and in plural
-func (g *Generator) crdNames() []string {
+func crdNames(crds []*ackmodel.CRD) []string {
 	var crdConfigs []string
 
-	crds, _ := g.GetCRDs()
 	for _, crd := range crds {
 		crdConfigs = append(crdConfigs, strings.ToLower(crd.Plural))
 	}
@@ -66,6 +72,20 @@ func (g *Generator) crdNames() []string {
 	return crdConfigs
 }
 
+// crdNamesWithScope returns the names, lowercased and in plural, of the crds
+// with the supplied scope
+func crdNamesWithScope(crds []*ackmodel.CRD, scope string) []string {
+	var crdConfigs []string
+
+	for _, crd := range crds {
+		if crd.Scope() == scope {
+			crdConfigs = append(crdConfigs, strings.ToLower(crd.Plural))
+		}
+	}
+
+	return crdConfigs
+}
+
 // GetCRDs returns a slice of `ackmodel.CRD` structs that describe the
 // top-level resources discovered by the code generator for an AWS service API
 func (g *Generator) GetCRDs() ([]*ackmodel.CRD, error) {
@@ -99,6 +119,11 @@ func (g *Generator) GetCRDs() ([]*ackmodel.CRD, error) {
 			SetAttributes: setAttributesOps[crdName],
 		}
 		g.RemoveIgnoredOperations(&ops)
+		if ops.Update == nil && g.cfg.ResourceIsSingleton(crdName) {
+			// A singleton resource is updated by the same Put operation that
+			// creates it
+			ops.Update = ops.Create
+		}
 		crd := ackmodel.NewCRD(g.SDKAPI, g.cfg, crdNames, ops)
 
 		// OK, begin to gather the CRDFields that will go into the Spec struct.
@@ -108,20 +133,55 @@ func (g *Generator) GetCRDs() ([]*ackmodel.CRD, error) {
 		if inputShape == nil {
 			return nil, ErrNilShapePointer
 		}
-		for memberName, memberShapeRef := range inputShape.MemberRefs {
-			if memberShapeRef.Shape == nil {
-				return nil, ErrNilShapePointer
-			}
+		addSpecField := func(memberName string, memberShapeRef *awssdkmodel.ShapeRef) {
 			renamedName, _ := crd.InputFieldRename(
 				createOp.Name, memberName,
 			)
+			if _, found := crd.SpecFields[renamedName]; found {
+				msg := fmt.Sprintf(
+					"GENERATION FAILURE! Spec field %s of resource %s is "+
+						"defined more than once. Rename one of the fields "+
+						"or disable unwrap_input.",
+					renamedName, crdName,
+				)
+				panic(msg)
+			}
 			memberNames := names.New(renamedName)
 			memberNames.ModelOriginal = memberName
+			crd.AddSpecField(memberNames, memberShapeRef)
+		}
+		// The members of a wrapper shape like CloudFront's
+		// DistributionConfig are promoted to top-level Spec fields, so field
+		// paths in the configuration that go through the wrapper member need
+		// to be rewritten before any field is added
+		wrapperName := crd.UnwrappedInputMember()
+		if wrapperName != "" {
+			g.cfg.TrimResourceFieldPathPrefix(
+				crdName, names.New(wrapperName).Camel,
+			)
+		}
+		for memberName, memberShapeRef := range inputShape.MemberRefs {
+			if memberShapeRef.Shape == nil {
+				return nil, ErrNilShapePointer
+			}
 			if memberName == "Attributes" && g.cfg.UnpacksAttributesMap(crdName) {
 				crd.UnpackAttributes()
 				continue
 			}
-			crd.AddSpecField(memberNames, memberShapeRef)
+			if crd.IsSingletonAccountIDField(memberName) {
+				// Set from the AWS account ID of the resource manager
+				continue
+			}
+			if memberName == wrapperName {
+				for wrappedName, wrappedShapeRef := range memberShapeRef.Shape.MemberRefs {
+					if wrappedShapeRef.Shape == nil {
+						return nil, ErrNilShapePointer
+					}
+					addSpecField(wrappedName, wrappedShapeRef)
+				}
+				continue
+			}
+			addSpecField(memberName, memberShapeRef)
 		}
 
 		// Now any additional Spec fields that are required from other API
@@ -185,11 +245,20 @@ func (g *Generator) GetCRDs() ([]*ackmodel.CRD, error) {
 			if memberName == "Attributes" && g.cfg.UnpacksAttributesMap(crdName) {
 				continue
 			}
+			if crd.IsUnwrappedInputMember(memberName, memberShapeRef) {
+				// The wrapper's members are already top-level Spec fields
+				continue
+			}
 			if crd.IsPrimaryARNField(memberName) {
 				// We automatically place the primary resource ARN value into
 				// the Status.ACKResourceMetadata.ARN field
 				continue
 			}
+			if crd.IsSingletonAccountIDField(memberName) {
+				// The AWS account ID is already in the
+				// Status.ACKResourceMetadata.OwnerAccountID field
+				continue
+			}
 			crd.AddStatusField(memberNames, memberShapeRef)
 		}
 
@@ -226,14 +295,68 @@ func (g *Generator) GetCRDs() ([]*ackmodel.CRD, error) {
 	sort.Slice(crds, func(i, j int) bool {
 		return crds[i].Names.Camel < crds[j].Names.Camel
 	})
+	// Now that all the CRDs are known, link child resources to their parent
+	g.processParentResources(crds)
 	// This is the place that we build out the CRD.Fields map with
 	// `pkg/model.Field` objects that represent the non-top-level Spec and
 	// Status fields.
 	g.processNestedFields(crds)
+	// The desired state Spec field of resources with actions takes its type
+	// from the lifecycle status field, which may be a nested field
+	for _, crd := range crds {
+		if err := crd.AddActionField(); err != nil {
+			return nil, err
+		}
+		// Fails generation early if a `compare.ignore` path does not exist
+		crd.CompareIgnoredPaths()
+	}
 	g.crds = crds
 	return crds, nil
 }
 
+// processParentResources links each child resource CRD to the CRD of its
+// parent resource, as configured in the `parent` block of the generator
+// config
+func (g *Generator) processParentResources(crds []*ackmodel.CRD) {
+	for _, crd := range crds {
+		parentConfig := g.cfg.ResourceParent(crd.Names.Original)
+		if parentConfig == nil {
+			continue
+		}
+		var parent *ackmodel.CRD
+		for _, candidate := range crds {
+			if candidate.Names.Original == parentConfig.Resource {
+				parent = candidate
+				break
+			}
+		}
+		if parent == nil {
+			// This is a compile-time failure, just bomb out...
+			msg := fmt.Sprintf(
+				"unknown parent resource %s for resource %s",
+				parentConfig.Resource, crd.Names.Original,
+			)
+			panic(msg)
+		}
+		if parent == crd {
+			msg := fmt.Sprintf(
+				"resource %s cannot be its own parent", crd.Names.Original,
+			)
+			panic(msg)
+		}
+		if crd.Scope() != parent.Scope() {
+			msg := fmt.Sprintf(
+				"GENERATION FAILURE! resource %s has scope %s but its "+
+					"parent resource %s has scope %s",
+				crd.Names.Original, crd.Scope(),
+				parent.Names.Original, parent.Scope(),
+			)
+			panic(msg)
+		}
+		crd.SetParent(parent)
+	}
+}
+
 // RemoveIgnoredOperations updates Ops argument by setting those
 // operations to nil that are configured to be ignored in generator config for
 // the AWS service
@@ -285,6 +408,12 @@ func (g *Generator) GetTypeDefs() ([]*ackmodel.TypeDef, error) {
 	trenames := map[string]string{}
 
 	payloads := g.SDKAPI.GetPayloads()
+	// The controller writes the observed state of the resource to the Status
+	// fields, so the enum validation markers, which the API server enforces
+	// on every write, are only emitted for types reachable from Spec fields.
+	// Otherwise a new enum value added by the AWS service would fail every
+	// patch of the custom resource's Status.
+	specShapes := g.specShapeNames()
 
 	for shapeName, shape := range g.SDKAPI.API.Shapes {
 		if util.InStrings(shapeName, payloads) {
@@ -315,50 +444,33 @@ func (g *Generator) GetTypeDefs() ([]*ackmodel.TypeDef, error) {
 			// fields in a DBProxy CRD... we need to ensure the type names don't
 			// conflict. Also, the name of the Go type in the generated code is
 			// Camel-cased and normalized, so we use that as the Go type
-			gt := memberShape.GoType()
-			if memberShape.Type == "structure" {
-				typeNames := names.New(memberShape.ShapeName)
-				if g.SDKAPI.HasConflictingTypeName(memberShape.ShapeName, g.cfg) {
-					typeNames.Camel += ackmodel.ConflictingNameSuffix
-				}
-				gt = "*" + typeNames.Camel
-			} else if memberShape.Type == "list" {
-				// If it's a list type, where the element is a structure, we need to
-				// set the GoType to the cleaned-up Camel-cased name
-				if memberShape.MemberRef.Shape.Type == "structure" {
-					elemType := memberShape.MemberRef.Shape.GoTypeElem()
-					typeNames := names.New(elemType)
-					if g.SDKAPI.HasConflictingTypeName(elemType, g.cfg) {
-						typeNames.Camel += ackmodel.ConflictingNameSuffix
-					}
-					gt = "[]*" + typeNames.Camel
-				}
-			} else if memberShape.Type == "map" {
-				// If it's a map type, where the value element is a structure,
-				// we need to set the GoType to the cleaned-up Camel-cased name
-				if memberShape.ValueRef.Shape.Type == "structure" {
-					valType := memberShape.ValueRef.Shape.GoTypeElem()
-					typeNames := names.New(valType)
-					if g.SDKAPI.HasConflictingTypeName(valType, g.cfg) {
-						typeNames.Camel += ackmodel.ConflictingNameSuffix
-					}
-					gt = "[]map[string]*" + typeNames.Camel
+			_, gt, _ := ackmodel.CleanGoType(g.SDKAPI, g.cfg, memberShape, nil)
+			if memberRef.JSONValue {
+				// aws.JSONValue documents are stored as structured JSON
+				// instead of a string containing an escaped JSON document
+				gt = ackmodel.JSONGoType
+			}
+			attr := ackmodel.NewAttr(memberNames, gt, memberShape)
+			attr.ShapeRef = memberRef
+			if !attr.IsJSON() && specShapes[shapeName] {
+				edef, err := ackmodel.NewEnumDefForShape(g.SDKAPI, g.cfg, memberShape)
+				if err != nil {
+					return nil, err
 				}
-			} else if memberShape.Type == "timestamp" {
-				// time.Time needs to be converted to apimachinery/metav1.Time
-				// otherwise there is no DeepCopy support
-				gt = "*metav1.Time"
+				attr.EnumDef = edef
 			}
-			attrs[memberName] = ackmodel.NewAttr(memberNames, gt, memberShape)
+			attrs[memberName] = attr
 		}
 		if len(attrs) == 0 {
 			// Just ignore these...
 			continue
 		}
 		tdefs = append(tdefs, &ackmodel.TypeDef{
-			Shape: shape,
-			Names: tdefNames,
-			Attrs: attrs,
+			Shape:         shape,
+			Names:         tdefNames,
+			Attrs:         attrs,
+			IsUnion:       g.SDKAPI.IsUnionShape(shape),
+			ValidateUnion: g.cfg.UnionValidation,
 		})
 	}
 	sort.Slice(tdefs, func(i, j int) bool {
@@ -401,6 +513,16 @@ func (g *Generator) processNestedFieldTypeDefs(
 				// struct)
 				replaceSecretAttrGoType(crd, field, tdefs)
 			}
+			if markers := field.Markers(); len(markers) > 0 {
+				// The TypeDef is shared by all fields of its type, so the
+				// markers of each of them are set on the attribute
+				attr := nestedFieldAttr(crd, field, tdefs)
+				for _, marker := range markers {
+					if !util.InStrings(marker, attr.Markers) {
+						attr.Markers = append(attr.Markers, marker)
+					}
+				}
+			}
 		}
 	}
 }
@@ -412,6 +534,17 @@ func replaceSecretAttrGoType(
 	field *ackmodel.Field,
 	tdefs []*ackmodel.TypeDef,
 ) {
+	attr := nestedFieldAttr(crd, field, tdefs)
+	attr.GoType = "*ackv1alpha1.SecretKeyReference"
+}
+
+// nestedFieldAttr returns the ackmodel.Attr of the TypeDef that was created
+// for the struct containing the supplied nested field
+func nestedFieldAttr(
+	crd *ackmodel.CRD,
+	field *ackmodel.Field,
+	tdefs []*ackmodel.TypeDef,
+) *ackmodel.Attr {
 	fieldPath := field.Path
 	parentFieldPath := ackmodel.ParentFieldPath(field.Path)
 	parentField, ok := crd.Fields[parentFieldPath]
@@ -470,8 +603,7 @@ func replaceSecretAttrGoType(
 		)
 		panic(msg)
 	}
-	// Now we modify the parent type def's Attr that corresponds to
-	// the secret field...
+	// Now we find the parent type def's Attr that corresponds to the field
 	attr, found := parentTypeDef.Attrs[field.Names.Camel]
 	if !found {
 		msg := fmt.Sprintf(
@@ -483,7 +615,7 @@ func replaceSecretAttrGoType(
 		)
 		panic(msg)
 	}
-	attr.GoType = "*ackv1alpha1.SecretKeyReference"
+	return attr
 }
 
 // processNestedFields is responsible for walking all of the CRDs' Spec and
@@ -626,21 +758,43 @@ func (g *Generator) processNestedMapField(
 
 // GetEnumDefs returns a slice of pointers to `ackmodel.EnumDef` structs which
 // represent string fields whose value is constrained to one or more specific
-// string values.
+// string values. Only enumerations used by a CRD field or by a TypeDef
+// attribute, either directly or as the element type of a list or map, are
+// returned.
 func (g *Generator) GetEnumDefs() ([]*ackmodel.EnumDef, error) {
-	edefs := []*ackmodel.EnumDef{}
+	crds, err := g.GetCRDs()
+	if err != nil {
+		return nil, err
+	}
+	tdefs, err := g.GetTypeDefs()
+	if err != nil {
+		return nil, err
+	}
+	usedShapes := map[string]bool{}
+	for _, crd := range crds {
+		for _, field := range crd.SpecFields {
+			if field.ShapeRef != nil {
+				markEnumShapeUsed(field.ShapeRef.Shape, usedShapes)
+			}
+		}
+		for _, field := range crd.StatusFields {
+			if field.ShapeRef != nil {
+				markEnumShapeUsed(field.ShapeRef.Shape, usedShapes)
+			}
+		}
+	}
+	for _, tdef := range tdefs {
+		for _, attr := range tdef.Attrs {
+			markEnumShapeUsed(attr.Shape, usedShapes)
+		}
+	}
 
+	edefs := []*ackmodel.EnumDef{}
 	for shapeName, shape := range g.SDKAPI.API.Shapes {
-		if !shape.IsEnum() {
+		if !shape.IsEnum() || !usedShapes[shapeName] {
 			continue
 		}
-		enumNames := names.New(shapeName)
-		// Handle name conflicts with top-level CRD.Spec or CRD.Status
-		// types
-		if g.SDKAPI.HasConflictingTypeName(shapeName, g.cfg) {
-			enumNames.Camel += ackmodel.ConflictingNameSuffix
-		}
-		edef, err := ackmodel.NewEnumDef(enumNames, shape.Enum)
+		edef, err := ackmodel.NewEnumDefForShape(g.SDKAPI, g.cfg, shape)
 		if err != nil {
 			return nil, err
 		}
@@ -652,6 +806,113 @@ func (g *Generator) GetEnumDefs() ([]*ackmodel.EnumDef, error) {
 	return edefs, nil
 }
 
+// markEnumShapeUsed records the name of the supplied shape, or of the element
+// shape of a list or the value shape of a map, at any level of nesting, if it
+// is an enum shape
+func markEnumShapeUsed(shape *awssdkmodel.Shape, used map[string]bool) {
+	if shape == nil {
+		return
+	}
+	switch shape.Type {
+	case "list":
+		markEnumShapeUsed(shape.MemberRef.Shape, used)
+	case "map":
+		markEnumShapeUsed(shape.ValueRef.Shape, used)
+	default:
+		if shape.IsEnum() {
+			used[shape.ShapeName] = true
+		}
+	}
+}
+
+// specShapeNames returns the names of the structure shapes reachable from
+// the Spec fields of any CRD, either directly or through other structures,
+// lists and maps
+func (g *Generator) specShapeNames() map[string]bool {
+	res := map[string]bool{}
+	crds, _ := g.GetCRDs()
+	for _, crd := range crds {
+		for _, field := range crd.SpecFields {
+			if field.ShapeRef != nil {
+				markStructShapeReachable(field.ShapeRef.Shape, res)
+			}
+		}
+	}
+	return res
+}
+
+// markStructShapeReachable records the name of the supplied shape and of
+// every structure shape reachable from it, if the supplied shape is a
+// structure, list or map
+func markStructShapeReachable(shape *awssdkmodel.Shape, seen map[string]bool) {
+	if shape == nil {
+		return
+	}
+	switch shape.Type {
+	case "structure":
+		if seen[shape.ShapeName] {
+			return
+		}
+		seen[shape.ShapeName] = true
+		for _, memberRef := range shape.MemberRefs {
+			markStructShapeReachable(memberRef.Shape, seen)
+		}
+	case "list":
+		markStructShapeReachable(shape.MemberRef.Shape, seen)
+	case "map":
+		markStructShapeReachable(shape.ValueRef.Shape, seen)
+	}
+}
+
+// GetDeprecatedFields returns the fields of the generated API types that the
+// AWS API model marks as deprecated, sorted by field path. Deprecated members
+// are only exposed when the generator config keeps them or the API version
+// has already been released, so this is the list of deprecated API surface of
+// the service controller.
+func (g *Generator) GetDeprecatedFields() ([]*ackmodel.DeprecatedField, error) {
+	crds, err := g.GetCRDs()
+	if err != nil {
+		return nil, err
+	}
+	tdefs, err := g.GetTypeDefs()
+	if err != nil {
+		return nil, err
+	}
+	dfields := []*ackmodel.DeprecatedField{}
+	for _, crd := range crds {
+		for _, field := range crd.SpecFields {
+			if field.IsDeprecated() {
+				dfields = append(dfields, &ackmodel.DeprecatedField{
+					Path:    crd.Names.Camel + ".Spec." + field.Names.Camel,
+					Message: field.DeprecatedMessage(),
+				})
+			}
+		}
+		for _, field := range crd.StatusFields {
+			if field.IsDeprecated() {
+				dfields = append(dfields, &ackmodel.DeprecatedField{
+					Path:    crd.Names.Camel + ".Status." + field.Names.Camel,
+					Message: field.DeprecatedMessage(),
+				})
+			}
+		}
+	}
+	for _, tdef := range tdefs {
+		for _, attr := range tdef.Attrs {
+			if attr.IsDeprecated() {
+				dfields = append(dfields, &ackmodel.DeprecatedField{
+					Path:    tdef.Names.Camel + "." + attr.Names.Camel,
+					Message: attr.DeprecatedMessage(),
+				})
+			}
+		}
+	}
+	sort.Slice(dfields, func(i, j int) bool {
+		return dfields[i].Path < dfields[j].Path
+	})
+	return dfields, nil
+}
+
 // ApplyShapeIgnoreRules removes the ignored shapes and fields from the API object
 // so that they are not considered in any of the calculations of code generator.
 func (g *Generator) ApplyShapeIgnoreRules() {
@@ -659,6 +920,17 @@ func (g *Generator) ApplyShapeIgnoreRules() {
 		return
 	}
 	for sdkShapeID, shape := range g.SDKAPI.API.Shapes {
+		// Deprecated members are dropped so that they never become API
+		// surface of a new API version, unless the generator config keeps
+		// them or the API version has already been released
+		for memberName, memberRef := range shape.MemberRefs {
+			if !ackmodel.IsDeprecatedShapeRef(memberRef) {
+				continue
+			}
+			if g.cfg.DropsDeprecatedField(g.apiVersion, shape.ShapeName, memberName) {
+				delete(shape.MemberRefs, memberName)
+			}
+		}
 		for _, fieldpath := range g.cfg.Ignore.FieldPaths {
 			sn := strings.Split(fieldpath, ".")[0]
 			fn := strings.Split(fieldpath, ".")[1]
@@ -103,3 +103,33 @@ func TestLambda_Function(t *testing.T) {
 	}
 	assert.Equal(expStatusFieldCamel, attrCamelNames(statusFields))
 }
+
+func TestLambda_NestedMaps(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewGeneratorForService(t, "lambda")
+
+	// SelfManagedEventSource.Endpoints is a map of lists of strings
+	crd := testutil.GetCRDByName(t, g, "EventSourceMapping")
+	require.NotNil(crd)
+
+	field := crd.Fields["SelfManagedEventSource.Endpoints"]
+	require.NotNil(field)
+	assert.Equal("map[string][]*string", field.GoType)
+
+	tdef := testutil.GetTypeDefByName(t, g, "SelfManagedEventSource")
+	require.NotNil(tdef)
+
+	attr := tdef.Attrs["Endpoints"]
+	require.NotNil(attr)
+	assert.Equal("map[string][]*string", attr.GoType)
+
+	// AliasRoutingConfiguration.AdditionalVersionWeights is a map of floats
+	tdef = testutil.GetTypeDefByName(t, g, "AliasRoutingConfiguration")
+	require.NotNil(tdef)
+
+	attr = tdef.Attrs["AdditionalVersionWeights"]
+	require.NotNil(attr)
+	assert.Equal("map[string]*float64", attr.GoType)
+}
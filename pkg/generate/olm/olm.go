@@ -57,11 +57,15 @@ func BundleAssets(
 	if err != nil {
 		return nil, err
 	}
+	metaVars, err := g.MetaVars()
+	if err != nil {
+		return nil, err
+	}
 
 	olmVars := templateOLMVars{
 		vers,
 		time.Now().Format("2006-01-02 15:04:05"),
-		g.MetaVars(),
+		metaVars,
 		commonMeta,
 		serviceConfig,
 		crds,
@@ -80,7 +84,7 @@ func BundleAssets(
 
 	csvBaseOutPath := fmt.Sprintf(
 		"config/manifests/bases/ack-%s-controller.clusterserviceversion.yaml",
-		g.MetaVars().ServiceIDClean)
+		metaVars.ServiceIDClean)
 	if err := ts.Add(csvBaseOutPath, "config/manifests/bases/clusterserviceversion.yaml.tpl", olmVars); err != nil {
 		return nil, err
 	}
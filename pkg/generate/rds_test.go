@@ -173,4 +173,51 @@ func TestRDS_DBInstance(t *testing.T) {
 		"VPCSecurityGroups",
 	}
 	assert.Equal(expStatusFieldCamel, attrCamelNames(statusFields))
+
+	// The readiness block in the generator config takes precedence over the
+	// DBInstanceAvailable waiter
+	readiness := crd.Readiness()
+	require.NotNil(readiness)
+	assert.Equal("DBInstanceStatus", readiness.Path)
+	assert.Contains(readiness.InProgress, "creating")
+	assert.Equal([]string{"deleting"}, crd.DeletingLifecycleValues())
+
+	readyWaiter := crd.ReadyWaiter()
+	require.NotNil(readyWaiter)
+	assert.Equal("DBInstanceAvailable", readyWaiter.Name)
+
+	// The DBInstanceDeleted waiter succeeds on a DBInstanceNotFound error.
+	// Its other success acceptor uses a JMESPath function and does not
+	// refer to a field of the resource.
+	deletionWaiter := crd.DeletionWaiter()
+	require.NotNil(deletionWaiter)
+	assert.Equal("DBInstanceDeleted", deletionWaiter.Name)
+	assert.Empty(crd.DeletedLifecycleValues())
+}
+
+func TestRDS_DBSnapshot_WaiterReadiness(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewGeneratorForService(t, "rds")
+
+	crd := testutil.GetCRDByName(t, g, "DBSnapshot")
+	require.NotNil(crd)
+
+	// The DBSnapshotAvailable waiter fails on every value it doesn't
+	// succeed on. The DBSnapshotDeleted waiter doesn't match on the status
+	// field, so without a `readiness` block in the generator config none
+	// of these values means the snapshot is being deleted.
+	readiness := crd.Readiness()
+	require.NotNil(readiness)
+	assert.Equal([]string{"available"}, readiness.Ready)
+	assert.Equal(
+		[]string{
+			"deleted", "deleting", "failed", "incompatible-restore",
+			"incompatible-parameters",
+		},
+		readiness.Failed,
+	)
+	assert.Empty(readiness.Deleting)
+	assert.Empty(crd.DeletingLifecycleValues())
 }
@@ -88,4 +88,11 @@ func TestS3_Bucket(t *testing.T) {
 		"Location",
 	}
 	assert.Equal(expStatusFieldCamel, attrCamelNames(statusFields))
+
+	// References to the renamed Bucket field in the documentation use the
+	// field's new name
+	expNameDoc := `// The name of the bucket to create.
+	//
+	// The ` + "`Name`" + ` name must be globally unique.`
+	assert.Equal(expNameDoc, specFields["Name"].Documentation())
 }
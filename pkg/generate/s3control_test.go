@@ -0,0 +1,97 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	 http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package generate_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/testutil"
+)
+
+func TestS3Control_PublicAccessBlock(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewGeneratorForService(t, "s3control")
+
+	crds, err := g.GetCRDs()
+	require.Nil(err)
+
+	crd := getCRDByName("PublicAccessBlock", crds)
+	require.NotNil(crd)
+
+	// There is one public access block configuration per AWS account. The
+	// generator.yaml makes it a cluster-scoped singleton resource.
+	assert.Equal("Cluster", crd.Scope())
+	assert.True(crd.IsClusterScoped())
+	assert.True(crd.IsSingleton())
+	assert.Equal(
+		"+kubebuilder:resource:shortName=pab,scope=Cluster",
+		crd.ResourceMarker(),
+	)
+
+	// The PutPublicAccessBlock operation both creates and updates the
+	// resource
+	require.NotNil(crd.Ops.Create)
+	assert.Equal("PutPublicAccessBlock", crd.Ops.Create.Name)
+	assert.Equal(crd.Ops.Create, crd.Ops.Update)
+	require.NotNil(crd.Ops.ReadOne)
+	assert.Equal("GetPublicAccessBlock", crd.Ops.ReadOne.Name)
+	require.NotNil(crd.Ops.Delete)
+	assert.Equal("DeletePublicAccessBlock", crd.Ops.Delete.Name)
+
+	// The AccountId member of PutPublicAccessBlockRequest is not a field of
+	// the resource, which leaves the PublicAccessBlockConfiguration member to
+	// be unwrapped into top-level Spec fields
+	assert.Equal("PublicAccessBlockConfiguration", crd.UnwrappedInputMember())
+	expSpecFieldCamel := []string{
+		"BlockPublicACLs",
+		"BlockPublicPolicy",
+		"IgnorePublicACLs",
+		"RestrictPublicBuckets",
+	}
+	assert.Equal(expSpecFieldCamel, attrCamelNames(crd.SpecFields))
+	assert.Empty(crd.StatusFields)
+
+	// A singleton resource has no identifier field
+	assert.Nil(crd.SpecIdentifierField())
+	assert.Empty(crd.IdentifierFields())
+
+	metaVars, err := g.MetaVars()
+	require.Nil(err)
+	assert.Empty(metaVars.NamespacedCRDNames)
+	assert.Equal([]string{"publicaccessblocks"}, metaVars.ClusterScopedCRDNames)
+	assert.Equal([]string{"publicaccessblocks"}, metaVars.CRDNames)
+}
+
+func TestS3Control_PublicAccessBlock_NamespacedSingleton(t *testing.T) {
+	assert := assert.New(t)
+
+	g := testutil.NewGeneratorForService(t, "s3control")
+
+	// A singleton resource has no identifier field, so generation fails
+	// unless its CRD is cluster-scoped
+	cfg := g.GetConfig()
+	rConfig := cfg.Resources["PublicAccessBlock"]
+	rConfig.Scope = ""
+	cfg.Resources["PublicAccessBlock"] = rConfig
+
+	assert.PanicsWithValue(
+		"GENERATION FAILURE! singleton resource PublicAccessBlock must have scope Cluster",
+		func() { g.GetCRDs() },
+	)
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/aws-controllers-k8s/code-generator/pkg/generate/code"
+	ackmodel "github.com/aws-controllers-k8s/code-generator/pkg/model"
 	"github.com/aws-controllers-k8s/code-generator/pkg/testutil"
 )
 
@@ -226,3 +227,100 @@ func TestSageMaker_RequeueOnSuccessSeconds_Default(t *testing.T) {
 	assert.Equal(0, crd.ReconcileRequeuOnSuccessSeconds())
 
 }
+
+func TestSageMaker_NotebookInstance_Actions(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewGeneratorForService(t, "sagemaker")
+
+	crds, err := g.GetCRDs()
+	require.Nil(err)
+
+	crd := getCRDByName("NotebookInstance", crds)
+	require.NotNil(crd)
+
+	// The StartNotebookInstance and StopNotebookInstance operations are
+	// neither Create, Update nor Delete operations. The generator config
+	// binds them to a DesiredState Spec field instead.
+	require.True(crd.HasActions())
+
+	field := crd.ActionField()
+	require.NotNil(field)
+	assert.Equal("DesiredState", field.Names.Camel)
+	assert.Equal("*string", field.GoType)
+	assert.False(field.IsRequired())
+	require.NotNil(field.EnumDef)
+	assert.Equal(
+		"+kubebuilder:validation:Enum=InService;Stopped",
+		field.EnumDef.ValidationMarker(),
+	)
+
+	actions := crd.Actions()
+	require.Len(actions, 2)
+	assert.Equal("InService", actions[0].Value)
+	assert.Equal([]string{"InService"}, actions[0].Observed)
+	assert.Equal("StartNotebookInstance", actions[0].Op.Name)
+	assert.Equal("Stopped", actions[1].Value)
+	assert.Equal("StopNotebookInstance", actions[1].Op.Name)
+}
+
+func TestSageMaker_FlowDefinition_JSONValue(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewGeneratorForService(t, "sagemaker")
+
+	crds, err := g.GetCRDs()
+	require.Nil(err)
+
+	crd := getCRDByName("FlowDefinition", crds)
+	require.NotNil(crd)
+
+	// The HumanLoopActivationConditionsConfig shape has a member that is an
+	// aws.JSONValue:
+	//
+	//    "HumanLoopActivationConditionsConfig":{
+	//      "type":"structure",
+	//      "required":["HumanLoopActivationConditions"],
+	//      "members":{
+	//        "HumanLoopActivationConditions":{
+	//          "shape":"HumanLoopActivationConditions",
+	//          "jsonvalue":true
+	//        }
+	//      }
+	//    },
+	//
+	// which is detected without any generator config.
+	field := crd.Fields["HumanLoopActivationConfig.HumanLoopActivationConditionsConfig.HumanLoopActivationConditions"]
+	require.NotNil(field)
+	assert.True(field.IsJSON())
+	assert.Equal("*apiextensionsv1.JSON", field.GoType)
+	assert.True(crd.HasJSONFields())
+
+	tdefs, err := g.GetTypeDefs()
+	require.Nil(err)
+
+	var tdef *ackmodel.TypeDef
+	for _, td := range tdefs {
+		if td.Names.Original == "HumanLoopActivationConditionsConfig" {
+			tdef = td
+		}
+	}
+	require.NotNil(tdef)
+	attr := tdef.Attrs["HumanLoopActivationConditions"]
+	require.NotNil(attr)
+	assert.True(attr.IsJSON())
+	assert.Equal(
+		map[string]string{
+			"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1": "apiextensionsv1",
+		},
+		ackmodel.TypeDefImports(tdefs),
+	)
+
+	// Resources without JSON documents don't need the extra imports
+	crd = getCRDByName("Endpoint", crds)
+	require.NotNil(crd)
+	assert.False(crd.HasJSONFields())
+	assert.Nil(crd.TypeImports)
+}
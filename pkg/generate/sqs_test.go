@@ -86,3 +86,36 @@ func TestSQS_Queue(t *testing.T) {
 	}
 	assert.Equal(expStatusFieldCamel, attrCamelNames(statusFields))
 }
+
+func TestSQS_Queue_JSONField(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewGeneratorForService(t, "sqs")
+
+	crds, err := g.GetCRDs()
+	require.Nil(err)
+
+	crd := getCRDByName("Queue", crds)
+	require.NotNil(crd)
+
+	// The Policy attribute is configured with `type: json` in the generator
+	// config, so it is rendered as a structured JSON document instead of a
+	// string containing an escaped JSON document
+	policyField := crd.SpecFields["Policy"]
+	require.NotNil(policyField)
+	assert.True(policyField.IsJSON())
+	assert.Equal("*apiextensionsv1.JSON", policyField.GoType)
+	assert.True(crd.IsJSONField("Policy"))
+
+	redrivePolicyField := crd.SpecFields["RedrivePolicy"]
+	require.NotNil(redrivePolicyField)
+	assert.False(redrivePolicyField.IsJSON())
+	assert.Equal("*string", redrivePolicyField.GoType)
+
+	assert.True(crd.HasJSONFields())
+	assert.Equal(
+		"apiextensionsv1",
+		crd.TypeImports["k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"],
+	)
+}
@@ -0,0 +1,224 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package templateset
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/scanner"
+	"go/token"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// goSourceExtension is the extension of the output paths whose contents are
+// formatted and have their unused imports removed after template execution
+const goSourceExtension = ".go"
+
+// majorVersionElement matches the last element of an import path that is a
+// major version suffix, e.g. the "v1" of "k8s.io/api/core/v1"
+var majorVersionElement = regexp.MustCompile(`^v[0-9]+$`)
+
+// goSourceError describes a rendered Go file that could not be parsed
+type goSourceError struct {
+	// line is the 1-based number of the first line that failed to parse
+	line int
+	// text is the content of that line
+	text string
+	err  error
+}
+
+func (e *goSourceError) Error() string {
+	return e.err.Error()
+}
+
+// formatGoSource removes the imports that the supplied Go source file does
+// not use and returns the file formatted as `gofmt` would format it. A
+// *goSourceError is returned when the source cannot be parsed.
+func formatGoSource(src []byte) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, newGoSourceError(src, err)
+	}
+	src = removeLines(src, unusedImportLines(fset, file))
+	formatted, err := format.Source(src)
+	if err != nil {
+		return nil, newGoSourceError(src, err)
+	}
+	return formatted, nil
+}
+
+// newGoSourceError returns a *goSourceError pointing at the first line of the
+// supplied source that the supplied parse error refers to
+func newGoSourceError(src []byte, err error) error {
+	line := 0
+	if errList, ok := err.(scanner.ErrorList); ok && len(errList) > 0 {
+		line = errList[0].Pos.Line
+	}
+	text := ""
+	lines := bytes.Split(src, []byte("\n"))
+	if line > 0 && line <= len(lines) {
+		text = strings.TrimSpace(string(lines[line-1]))
+	}
+	return &goSourceError{line: line, text: text, err: err}
+}
+
+// unusedImportLines returns the set of 1-based line numbers that hold the
+// imports, and any import declarations left empty, that the supplied file does
+// not use
+func unusedImportLines(fset *token.FileSet, file *ast.File) map[int]bool {
+	used := usedPackageNames(file)
+	unused := map[int]bool{}
+	kept := map[int]bool{}
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.IMPORT {
+			continue
+		}
+		removed := 0
+		for _, spec := range genDecl.Specs {
+			importSpec := spec.(*ast.ImportSpec)
+			start := importSpec.Pos()
+			if importSpec.Doc != nil {
+				start = importSpec.Doc.Pos()
+			}
+			end := importSpec.End()
+			if importSpec.Comment != nil {
+				end = importSpec.Comment.End()
+			}
+			lines := lineRange(fset, start, end)
+			if isImportUsed(importSpec, used) {
+				for _, line := range lines {
+					kept[line] = true
+				}
+				continue
+			}
+			for _, line := range lines {
+				unused[line] = true
+			}
+			removed++
+		}
+		if removed == len(genDecl.Specs) {
+			start := genDecl.Pos()
+			if genDecl.Doc != nil {
+				start = genDecl.Doc.Pos()
+			}
+			for _, line := range lineRange(fset, start, genDecl.End()) {
+				unused[line] = true
+			}
+		}
+	}
+	// Lines shared with an import that is used must stay
+	for line := range kept {
+		delete(unused, line)
+	}
+	return unused
+}
+
+// usedPackageNames returns the set of identifiers that the supplied file
+// selects from without declaring them, i.e. the names of the imported
+// packages the file refers to
+func usedPackageNames(file *ast.File) map[string]bool {
+	used := map[string]bool{}
+	ast.Inspect(file, func(n ast.Node) bool {
+		sel, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		if ident, ok := sel.X.(*ast.Ident); ok && ident.Obj == nil {
+			used[ident.Name] = true
+		}
+		return true
+	})
+	return used
+}
+
+// isImportUsed returns true if the supplied import is used. Blank and dot
+// imports are always used. Imports without an explicit name are matched
+// against the names their package may declare, keeping the import when in
+// doubt.
+func isImportUsed(importSpec *ast.ImportSpec, used map[string]bool) bool {
+	if importSpec.Name != nil {
+		name := importSpec.Name.Name
+		return name == "_" || name == "." || used[name]
+	}
+	importPath, err := strconv.Unquote(importSpec.Path.Value)
+	if err != nil {
+		return true
+	}
+	for _, name := range packageNameCandidates(importPath) {
+		if used[name] {
+			return true
+		}
+	}
+	return false
+}
+
+// packageNameCandidates returns the names that the package at the supplied
+// import path may declare, e.g. "yaml" for "gopkg.in/yaml.v2" and both "v1"
+// and "meta" for "k8s.io/apimachinery/pkg/apis/meta/v1"
+func packageNameCandidates(importPath string) []string {
+	elements := strings.Split(importPath, "/")
+	last := elements[len(elements)-1]
+	candidates := []string{}
+	if majorVersionElement.MatchString(last) && len(elements) > 1 {
+		candidates = append(candidates, last)
+		last = elements[len(elements)-2]
+	}
+	if ext := path.Ext(last); majorVersionElement.MatchString(strings.TrimPrefix(ext, ".")) {
+		last = strings.TrimSuffix(last, ext)
+	}
+	candidates = append(candidates, last)
+	for _, prefix := range []string{"go-", "go."} {
+		if strings.HasPrefix(last, prefix) {
+			candidates = append(candidates, strings.TrimPrefix(last, prefix))
+		}
+	}
+	if strings.ContainsAny(last, "-.") {
+		candidates = append(
+			candidates, strings.NewReplacer("-", "", ".", "").Replace(last),
+		)
+	}
+	return candidates
+}
+
+// lineRange returns the 1-based numbers of the lines spanned by the supplied
+// positions
+func lineRange(fset *token.FileSet, start token.Pos, end token.Pos) []int {
+	lines := []int{}
+	for line := fset.Position(start).Line; line <= fset.Position(end).Line; line++ {
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// removeLines returns the supplied source without the lines whose 1-based
+// numbers are in the supplied set
+func removeLines(src []byte, lines map[int]bool) []byte {
+	if len(lines) == 0 {
+		return src
+	}
+	var b bytes.Buffer
+	for x, line := range bytes.SplitAfter(src, []byte("\n")) {
+		if !lines[x+1] {
+			b.Write(line)
+		}
+	}
+	return b.Bytes()
+}
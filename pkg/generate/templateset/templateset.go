@@ -19,10 +19,14 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
 	ttpl "text/template"
 
 	"github.com/pkg/errors"
 
+	ackmodel "github.com/aws-controllers-k8s/code-generator/pkg/model"
 	ackutil "github.com/aws-controllers-k8s/code-generator/pkg/util"
 )
 
@@ -31,6 +35,10 @@ var (
 	// in any base search path
 	ErrTemplateNotFound    = errors.New("Template Not Found")
 	errMsgTemplateNotFound = "template %s not found in any base search path"
+	// ErrInvalidGoSource is returned when a template rendering a Go file
+	// produces output that cannot be parsed as Go source
+	ErrInvalidGoSource    = errors.New("Invalid Go Source")
+	errMsgInvalidGoSource = "template %s rendered %s%s with a syntax error at line %d: %q: %v"
 )
 
 func errTemplateNotFound(templatePath string) error {
@@ -40,6 +48,42 @@ func errTemplateNotFound(templatePath string) error {
 	)
 }
 
+func errInvalidGoSource(tv templateWithVars, outPath string, err error) error {
+	forCRD := ""
+	if crdName := crdNameFromVars(tv.v); crdName != "" {
+		forCRD = fmt.Sprintf(" for CRD %s", crdName)
+	}
+	line, text := 0, ""
+	if srcErr, ok := err.(*goSourceError); ok {
+		line, text = srcErr.line, srcErr.text
+	}
+	return errors.WithMessage(
+		ErrInvalidGoSource,
+		fmt.Sprintf(
+			errMsgInvalidGoSource,
+			tv.t.Name(), outPath, forCRD, line, text, err,
+		),
+	)
+}
+
+// crdNameFromVars returns the name of the CRD that the supplied template
+// variables describe, or the empty string if the variables have no `CRD`
+// field
+func crdNameFromVars(vars interface{}) string {
+	v := reflect.Indirect(reflect.ValueOf(vars))
+	if v.Kind() != reflect.Struct {
+		return ""
+	}
+	f := v.FieldByName("CRD")
+	if !f.IsValid() || !f.CanInterface() {
+		return ""
+	}
+	if crd, ok := f.Interface().(*ackmodel.CRD); ok && crd != nil {
+		return crd.Names.Original
+	}
+	return ""
+}
+
 // templateWithVars contains a template and the variables injected during execution
 type templateWithVars struct {
 	t *ttpl.Template
@@ -130,15 +174,33 @@ func (ts *TemplateSet) joinIncludes(t *ttpl.Template) error {
 }
 
 // Execute runs all of the template and copy files in our TemplateSet and
-// returns whether any error occurred executing any of the templates. Once
+// returns whether any error occurred executing any of the templates. The
+// output of templates rendering Go files is formatted and stripped of unused
+// imports, and an error naming the template, the offending line and the CRD
+// is returned if it isn't valid Go source. Once
 // Execute() is run, `TemplateSet.Executed()` can be used to iterate over a set
 // of byte buffers containing the output of executed templates
 func (ts *TemplateSet) Execute() error {
-	for path, tv := range ts.templates {
+	// Templates are executed in order of their output path so that the
+	// same error is returned on every run when more than one template fails
+	paths := make([]string, 0, len(ts.templates))
+	for path := range ts.templates {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		tv := ts.templates[path]
 		var b bytes.Buffer
 		if err := tv.t.Execute(&b, tv.v); err != nil {
 			return err
 		}
+		if strings.HasSuffix(path, goSourceExtension) {
+			formatted, err := formatGoSource(b.Bytes())
+			if err != nil {
+				return errInvalidGoSource(tv, path, err)
+			}
+			b = *bytes.NewBuffer(formatted)
+		}
 		ts.executed[path] = &b
 	}
 	for _, basePath := range ts.baseSearchPaths {
@@ -163,6 +225,18 @@ func (ts *TemplateSet) Executed() map[string]*bytes.Buffer {
 	return ts.executed
 }
 
+// Origin returns the path of the template that renders the supplied output
+// path and the name of the CRD the template is executed for, if any. The
+// returned template path is empty if no template renders the output path,
+// e.g. for copy files.
+func (ts *TemplateSet) Origin(outPath string) (string, string) {
+	tv, found := ts.templates[outPath]
+	if !found {
+		return "", ""
+	}
+	return tv.t.Name(), crdNameFromVars(tv.v)
+}
+
 func byteBufferFromFile(path string) (*bytes.Buffer, error) {
 	f, err := os.Open(path)
 	if err != nil {
@@ -0,0 +1,177 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package templateset_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/generate/templateset"
+	ackmodel "github.com/aws-controllers-k8s/code-generator/pkg/model"
+	"github.com/aws-controllers-k8s/code-generator/pkg/names"
+)
+
+// executeTemplate executes a template set holding the supplied template and
+// returns the output for the supplied output path
+func executeTemplate(
+	t *testing.T,
+	outPath string,
+	tplContents string,
+	vars interface{},
+) (string, error) {
+	require := require.New(t)
+
+	baseDir, err := ioutil.TempDir("", "templateset")
+	require.Nil(err)
+	defer os.RemoveAll(baseDir)
+	err = ioutil.WriteFile(
+		filepath.Join(baseDir, "test.tpl"), []byte(tplContents), 0666,
+	)
+	require.Nil(err)
+
+	ts := templateset.New([]string{baseDir}, nil, nil, nil)
+	require.Nil(ts.Add(outPath, "test.tpl", vars))
+	if err := ts.Execute(); err != nil {
+		return "", err
+	}
+	return ts.Executed()[outPath].String(), nil
+}
+
+func TestExecute_GoSourceFormatted(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	tpl := `package foo
+
+import (
+	"fmt"
+	"strings"
+	_ "embed"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1 "k8s.io/api/core/v1"
+	"gopkg.in/yaml.v2"
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+func Foo(  t *metav1.Time ) string {
+	strings := []string{"a"}
+	_ = yaml.Marshal
+	return strings[0]
+}
+`
+	expected := `package foo
+
+import (
+	_ "embed"
+	"gopkg.in/yaml.v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Foo(t *metav1.Time) string {
+	strings := []string{"a"}
+	_ = yaml.Marshal
+	return strings[0]
+}
+`
+	got, err := executeTemplate(t, "pkg/foo/foo.go", tpl, nil)
+	require.Nil(err)
+	assert.Equal(expected, got)
+
+	// Files that aren't Go source are left alone
+	got, err = executeTemplate(t, "foo.yaml", "a:   b\n", nil)
+	require.Nil(err)
+	assert.Equal("a:   b\n", got)
+}
+
+func TestExecute_GoSourceImportDeclRemoved(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	tpl := `package foo
+
+import "fmt"
+
+// Foo is foo
+func Foo() {}
+`
+	expected := `package foo
+
+// Foo is foo
+func Foo() {}
+`
+	got, err := executeTemplate(t, "foo.go", tpl, nil)
+	require.Nil(err)
+	assert.Equal(expected, got)
+}
+
+func TestExecute_GoSourceInvalid(t *testing.T) {
+	assert := assert.New(t)
+
+	tpl := `package foo
+
+func Foo() *{{ .CRD.Names.Camel }} {
+	return {{ .CRD.Names.Camel }} nil
+}
+`
+	vars := struct {
+		CRD *ackmodel.CRD
+	}{
+		CRD: &ackmodel.CRD{Names: names.New("Repository")},
+	}
+	_, err := executeTemplate(t, "pkg/foo/foo.go", tpl, vars)
+	assert.NotNil(err)
+	assert.Equal(templateset.ErrInvalidGoSource, errors.Cause(err))
+	assert.Contains(err.Error(), "test.tpl")
+	assert.Contains(err.Error(), "pkg/foo/foo.go")
+	assert.Contains(err.Error(), "for CRD Repository")
+	assert.Contains(err.Error(), `line 4: "return Repository nil"`)
+}
+
+func TestOrigin(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	baseDir, err := ioutil.TempDir("", "templateset")
+	require.Nil(err)
+	defer os.RemoveAll(baseDir)
+	tplPath := filepath.Join(baseDir, "test.tpl")
+	require.Nil(ioutil.WriteFile(tplPath, []byte("package foo\n"), 0666))
+
+	ts := templateset.New([]string{baseDir}, nil, nil, nil)
+	vars := struct {
+		CRD *ackmodel.CRD
+	}{
+		CRD: &ackmodel.CRD{Names: names.New("Repository")},
+	}
+	require.Nil(ts.Add("pkg/foo/foo.go", "test.tpl", vars))
+	require.Nil(ts.Add("pkg/bar/bar.go", "test.tpl", nil))
+
+	templatePath, crdName := ts.Origin("pkg/foo/foo.go")
+	assert.Equal(tplPath, templatePath)
+	assert.Equal("Repository", crdName)
+
+	templatePath, crdName = ts.Origin("pkg/bar/bar.go")
+	assert.Equal(tplPath, templatePath)
+	assert.Equal("", crdName)
+
+	templatePath, crdName = ts.Origin("pkg/baz/baz.go")
+	assert.Equal("", templatePath)
+	assert.Equal("", crdName)
+}
@@ -39,4 +39,10 @@ type MetaVars struct {
 	SDKAPIInterfaceTypeName string
 	//CRDNames contains all crds names lowercased and in plural
 	CRDNames []string
+	// NamespacedCRDNames contains the names, lowercased and in plural, of the
+	// crds whose custom resources live in a Namespace
+	NamespacedCRDNames []string
+	// ClusterScopedCRDNames contains the names, lowercased and in plural, of
+	// the crds whose custom resources are global to the cluster
+	ClusterScopedCRDNames []string
 }
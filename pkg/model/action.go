@@ -0,0 +1,146 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package model
+
+import (
+	"fmt"
+
+	awssdkmodel "github.com/aws/aws-sdk-go/private/model/api"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/names"
+)
+
+// Action describes a runtime state that a resource can be placed into and
+// the API operation that transitions the resource into that state
+type Action struct {
+	// Value is the value of the resource's desired state Spec field
+	Value string
+	// Observed is the list of values of the resource's lifecycle status
+	// field meaning the resource is in this state
+	Observed []string
+	// Op is the API operation that transitions the resource into this state
+	Op *awssdkmodel.Operation
+}
+
+// HasActions returns true if the resource has a desired state Spec field
+// bound to API operations that transition the resource between states
+func (r *CRD) HasActions() bool {
+	return r.cfg.ResourceActions(r.Names.Original) != nil
+}
+
+// Actions returns the runtime states the resource can be placed into, in the
+// order they are listed in the generator config
+func (r *CRD) Actions() []*Action {
+	actionsConfig := r.cfg.ResourceActions(r.Names.Original)
+	if actionsConfig == nil {
+		return nil
+	}
+	res := []*Action{}
+	for _, stateConfig := range actionsConfig.States {
+		op, found := r.sdkAPI.API.Operations[stateConfig.Operation]
+		if !found {
+			msg := fmt.Sprintf(
+				"GENERATION FAILURE! unknown operation %s for action %s "+
+					"of resource %s",
+				stateConfig.Operation, stateConfig.Value, r.Names.Original,
+			)
+			panic(msg)
+		}
+		observed := stateConfig.Observed
+		if len(observed) == 0 {
+			observed = []string{stateConfig.Value}
+		}
+		res = append(res, &Action{
+			Value:    stateConfig.Value,
+			Observed: observed,
+			Op:       op,
+		})
+	}
+	return res
+}
+
+// ActionField returns the Spec field containing the desired runtime state
+// of the resource, or nil if the resource has no actions configured
+func (r *CRD) ActionField() *Field {
+	actionsConfig := r.cfg.ResourceActions(r.Names.Original)
+	if actionsConfig == nil {
+		return nil
+	}
+	return r.SpecFields[actionsConfig.Field]
+}
+
+// AddActionField adds the Spec field containing the desired runtime state of
+// the resource. The field has the same type as the resource's lifecycle
+// status field, described by the resource's readiness config.
+func (r *CRD) AddActionField() error {
+	actionsConfig := r.cfg.ResourceActions(r.Names.Original)
+	if actionsConfig == nil {
+		return nil
+	}
+	if actionsConfig.Field == "" || len(actionsConfig.States) == 0 {
+		msg := fmt.Sprintf(
+			"GENERATION FAILURE! actions config for resource %s must "+
+				"specify a field and at least one state",
+			r.Names.Original,
+		)
+		panic(msg)
+	}
+	if _, found := r.SpecFields[actionsConfig.Field]; found {
+		msg := fmt.Sprintf(
+			"GENERATION FAILURE! actions field %s of resource %s collides "+
+				"with an existing Spec field",
+			actionsConfig.Field, r.Names.Original,
+		)
+		panic(msg)
+	}
+	readiness := r.Readiness()
+	if readiness == nil {
+		msg := fmt.Sprintf(
+			"GENERATION FAILURE! resource %s has actions but no lifecycle "+
+				"status field. Add a readiness config for the resource.",
+			r.Names.Original,
+		)
+		panic(msg)
+	}
+	fields := r.FieldsAlongPath(readiness.Path)
+	if len(fields) == 0 {
+		msg := fmt.Sprintf(
+			"GENERATION FAILURE! readiness path %s does not refer to a "+
+				"field of resource %s",
+			readiness.Path, r.Names.Original,
+		)
+		panic(msg)
+	}
+	statusShapeRef := fields[len(fields)-1].ShapeRef
+	values := []string{}
+	for _, action := range r.Actions() {
+		values = append(values, action.Value)
+	}
+	shapeRef := &awssdkmodel.ShapeRef{
+		ShapeName:     statusShapeRef.ShapeName,
+		Shape:         statusShapeRef.Shape,
+		Documentation: "The desired runtime state of the resource.",
+	}
+	fieldNames := names.New(actionsConfig.Field)
+	r.AddSpecField(fieldNames, shapeRef)
+	// The field only accepts the configured runtime states, not every value
+	// of the lifecycle status field
+	enumNames := names.New(r.Names.Original + actionsConfig.Field)
+	enumDef, err := NewEnumDef(enumNames, values)
+	if err != nil {
+		return err
+	}
+	r.SpecFields[actionsConfig.Field].EnumDef = enumDef
+	return nil
+}
@@ -23,6 +23,16 @@ type Attr struct {
 	Names  names.Names
 	GoType string
 	Shape  *awssdkmodel.Shape
+	// ShapeRef is the member reference the attribute was created from, or nil
+	// if the attribute was not created from a shape member
+	ShapeRef *awssdkmodel.ShapeRef
+	// EnumDef is the enumeration the attribute's string value is constrained
+	// to, or nil if the attribute is not an enum-backed string or its type is
+	// not reachable from a Spec field
+	EnumDef *EnumDef
+	// Markers are the kubebuilder markers configured for the nested fields
+	// that the attribute represents
+	Markers []string
 }
 
 func NewAttr(
@@ -36,3 +46,8 @@ func NewAttr(
 		Shape:  shape,
 	}
 }
+
+// IsJSON returns true if the attribute contains a JSON document
+func (a *Attr) IsJSON() bool {
+	return a.GoType == JSONGoType
+}
@@ -0,0 +1,257 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package model
+
+import (
+	"fmt"
+	"go/token"
+	"sort"
+	"strings"
+
+	awssdkmodel "github.com/aws/aws-sdk-go/private/model/api"
+
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/generate/config"
+	"github.com/aws-controllers-k8s/code-generator/pkg/names"
+)
+
+// IsUnorderedList returns true if the supplied compare config instructs the
+// code generator to compare the list field at the supplied field path as a
+// set, ignoring the order of its elements
+func IsUnorderedList(
+	shape *awssdkmodel.Shape,
+	compareConfig *ackgenconfig.CompareFieldConfig,
+	fieldPath string,
+) bool {
+	if compareConfig == nil || !compareConfig.Unordered {
+		return false
+	}
+	if shape == nil || shape.Type != "list" {
+		msg := fmt.Sprintf(
+			"GENERATION FAILURE! field %s is configured with "+
+				"compare.unordered but is not a list",
+			fieldPath,
+		)
+		panic(msg)
+	}
+	return true
+}
+
+// ListKeyFields returns the Go names of the members of the struct elements
+// of the list field at the supplied field path that identify an element, as
+// configured with `compare.key_fields`, or nil if the list's elements are
+// matched by index
+func ListKeyFields(
+	shape *awssdkmodel.Shape,
+	compareConfig *ackgenconfig.CompareFieldConfig,
+	fieldPath string,
+) []string {
+	if compareConfig == nil || len(compareConfig.KeyFields) == 0 {
+		return nil
+	}
+	if shape == nil || shape.Type != "list" ||
+		shape.MemberRef.Shape.Type != "structure" {
+		msg := fmt.Sprintf(
+			"GENERATION FAILURE! field %s is configured with "+
+				"compare.key_fields but is not a list of structs",
+			fieldPath,
+		)
+		panic(msg)
+	}
+	elemShape := shape.MemberRef.Shape
+	keyFields := make([]string, 0, len(compareConfig.KeyFields))
+	for _, keyField := range compareConfig.KeyFields {
+		// Key fields may be named as in the API model or as in the CRD
+		keyFieldCamel := names.New(keyField).Camel
+		found := false
+		for _, memberName := range elemShape.MemberNames() {
+			if names.New(memberName).Camel == keyFieldCamel {
+				found = true
+				break
+			}
+		}
+		if !found {
+			msg := fmt.Sprintf(
+				"GENERATION FAILURE! key field %s of field %s is not a "+
+					"member of %s",
+				keyField, fieldPath, elemShape.ShapeName,
+			)
+			panic(msg)
+		}
+		keyFields = append(keyFields, keyFieldCamel)
+	}
+	return keyFields
+}
+
+// IsUnorderedList returns true if the field is a list that is compared as a
+// set, ignoring the order of its elements
+func (f *Field) IsUnorderedList() bool {
+	if f.ShapeRef == nil || f.FieldConfig == nil {
+		return false
+	}
+	return IsUnorderedList(f.ShapeRef.Shape, f.FieldConfig.Compare, f.Path)
+}
+
+// ListKeyFields returns the Go names of the members that identify an
+// element of the field's list of structs, or nil if the elements are
+// matched by index
+func (f *Field) ListKeyFields() []string {
+	if f.ShapeRef == nil || f.FieldConfig == nil {
+		return nil
+	}
+	return ListKeyFields(f.ShapeRef.Shape, f.FieldConfig.Compare, f.Path)
+}
+
+// HasUnorderedListFields returns true if any of the resource's fields, at
+// any depth, is a list compared as a set
+func (r *CRD) HasUnorderedListFields() bool {
+	for _, f := range r.Fields {
+		if f.IsUnorderedList() {
+			return true
+		}
+	}
+	return false
+}
+
+// compareElementWildcard matches any element of a list or value of a map in
+// a `compare.ignore` path, e.g. "Spec.Rules[*].Port"
+const compareElementWildcard = "[*]"
+
+// normalizeComparePath returns the supplied compare field path in the form of
+// the keys of CRD.Fields, e.g. "Rules..Port" for "Spec.Rules[*].Port". The
+// "Spec." prefix is optional and the field names may be the names of the
+// API model members or of the CRD fields.
+func normalizeComparePath(path string) string {
+	path = strings.TrimPrefix(path, "Spec.")
+	path = strings.ReplaceAll(path, compareElementWildcard, ".")
+	parts := strings.Split(path, ".")
+	for x, part := range parts {
+		if part != "" {
+			parts[x] = names.New(part).Camel
+		}
+	}
+	return strings.Join(parts, ".")
+}
+
+// CompareIgnoredPaths returns the set of the paths of the Spec fields, in the
+// form of the keys of CRD.Fields, that compare logic ignores. These are the
+// paths in the resource's `compare.ignore` config and the paths of the fields
+// configured with `compare.is_ignored`. The set is built once per resource.
+//
+// The function panics if a `compare.ignore` path is not the path of one of
+// the resource's Spec fields or of a field nested in one.
+func (r *CRD) CompareIgnoredPaths() map[string]bool {
+	if r.compareIgnoredPaths != nil {
+		return r.compareIgnoredPaths
+	}
+	ignored := map[string]bool{}
+	for fieldPath, fieldConfig := range r.cfg.ResourceFields(r.Names.Original) {
+		if fieldConfig != nil && fieldConfig.Compare != nil &&
+			fieldConfig.Compare.IsIgnored {
+			ignored[normalizeComparePath(fieldPath)] = true
+		}
+	}
+	specFieldNames := map[string]bool{}
+	for _, f := range r.SpecFields {
+		specFieldNames[f.Names.Camel] = true
+	}
+	for _, ignorePath := range r.CompareIgnoredFields() {
+		fieldPath := normalizeComparePath(ignorePath)
+		topLevelName := strings.SplitN(fieldPath, ".", 2)[0]
+		_, found := r.Fields[fieldPath]
+		if !found || !specFieldNames[topLevelName] {
+			msg := fmt.Sprintf(
+				"GENERATION FAILURE! compare.ignore path %s of resource %s "+
+					"is not a Spec field",
+				ignorePath, r.Names.Original,
+			)
+			panic(msg)
+		}
+		ignored[fieldPath] = true
+	}
+	r.compareIgnoredPaths = ignored
+	return ignored
+}
+
+// IsCompareIgnoredPath returns true if compare logic ignores the Spec field
+// at the supplied path, e.g. "Config.LastModified" or "Rules..Port"
+func (r *CRD) IsCompareIgnoredPath(fieldPath string) bool {
+	return r.CompareIgnoredPaths()[normalizeComparePath(fieldPath)]
+}
+
+// builtinComparators maps the names of the comparators that may be named in
+// `compare.comparator` to the Go functions implementing them in
+// `templates/pkg/resource/delta.go.tpl`
+var builtinComparators = map[string]string{
+	"arn_or_name":      "equalARNOrName",
+	"case_insensitive": "equalCaseInsensitive",
+	"cidr":             "equalCIDR",
+	"json":             "equalJSONString",
+	"numeric_string":   "equalNumericString",
+}
+
+// ComparatorFunc returns the name of the Go function that compares two values
+// of the field at the supplied field path, as configured with
+// `compare.comparator`, or the empty string if the field's values are
+// compared by type
+//
+// The function panics if the comparator is a built-in comparator and the
+// field is not a string field, or is neither a built-in comparator nor a Go
+// identifier.
+func ComparatorFunc(
+	shape *awssdkmodel.Shape,
+	compareConfig *ackgenconfig.CompareFieldConfig,
+	fieldPath string,
+) string {
+	if compareConfig == nil || compareConfig.Comparator == "" {
+		return ""
+	}
+	comparator := compareConfig.Comparator
+	if funcName, found := builtinComparators[comparator]; found {
+		if shape == nil || shape.Type != "string" {
+			msg := fmt.Sprintf(
+				"GENERATION FAILURE! field %s is configured with the %s "+
+					"comparator but is not a string field",
+				fieldPath, comparator,
+			)
+			panic(msg)
+		}
+		return funcName
+	}
+	if !token.IsIdentifier(comparator) {
+		builtins := make([]string, 0, len(builtinComparators))
+		for name := range builtinComparators {
+			builtins = append(builtins, name)
+		}
+		sort.Strings(builtins)
+		msg := fmt.Sprintf(
+			"GENERATION FAILURE! comparator %s of field %s is neither one "+
+				"of %v nor the name of a Go function",
+			comparator, fieldPath, builtins,
+		)
+		panic(msg)
+	}
+	return comparator
+}
+
+// UsesComparator returns true if any of the resource's fields, at any depth,
+// is compared with the supplied built-in comparator, e.g. "json"
+func (r *CRD) UsesComparator(comparator string) bool {
+	for _, f := range r.Fields {
+		if f.FieldConfig != nil && f.FieldConfig.Compare != nil &&
+			f.FieldConfig.Compare.Comparator == comparator {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,71 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package model_test
+
+import (
+	"testing"
+
+	awssdkmodel "github.com/aws/aws-sdk-go/private/model/api"
+	"github.com/stretchr/testify/assert"
+
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/generate/config"
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
+)
+
+func TestComparatorFunc(t *testing.T) {
+	assert := assert.New(t)
+
+	stringShape := &awssdkmodel.Shape{Type: "string"}
+	integerShape := &awssdkmodel.Shape{Type: "integer"}
+	comparator := func(name string) *ackgenconfig.CompareFieldConfig {
+		return &ackgenconfig.CompareFieldConfig{Comparator: name}
+	}
+
+	assert.Equal("", model.ComparatorFunc(stringShape, nil, "Engine"))
+	assert.Equal("", model.ComparatorFunc(stringShape, comparator(""), "Engine"))
+	assert.Equal(
+		"equalCaseInsensitive",
+		model.ComparatorFunc(stringShape, comparator("case_insensitive"), "Engine"),
+	)
+	assert.Equal(
+		"equalJSONString",
+		model.ComparatorFunc(stringShape, comparator("json"), "Policy"),
+	)
+	assert.Equal(
+		"equalARNOrName",
+		model.ComparatorFunc(stringShape, comparator("arn_or_name"), "KmsKeyId"),
+	)
+	assert.Equal(
+		"equalCIDR",
+		model.ComparatorFunc(stringShape, comparator("cidr"), "CidrBlock"),
+	)
+	assert.Equal(
+		"equalNumericString",
+		model.ComparatorFunc(stringShape, comparator("numeric_string"), "Size"),
+	)
+	// Custom comparators may be used with fields of any type
+	assert.Equal(
+		"equalPort",
+		model.ComparatorFunc(integerShape, comparator("equalPort"), "Port"),
+	)
+
+	// Built-in comparators only compare strings
+	assert.Panics(func() {
+		model.ComparatorFunc(integerShape, comparator("numeric_string"), "Port")
+	})
+	// Custom comparators must be Go function names
+	assert.Panics(func() {
+		model.ComparatorFunc(stringShape, comparator("case-insensitive"), "Engine")
+	})
+}
@@ -89,6 +89,14 @@ type CRD struct {
 	// ShortNames represent the CRD list of aliases. Short names allow shorter
 	// strings to match a CR on the CLI.
 	ShortNames []string
+	// parent is the CRD of the resource that contains this resource. It is
+	// nil unless the resource is configured as a child resource.
+	parent *CRD
+	// children are the CRDs of the resources contained by this resource
+	children []*CRD
+	// compareIgnoredPaths caches the set of paths returned by
+	// CompareIgnoredPaths, which is looked up for every compared field
+	compareIgnoredPaths map[string]bool
 }
 
 // Config returns a pointer to the generator config
@@ -113,9 +121,11 @@ func (r *CRD) TypeRenames() map[string]string {
 func (r *CRD) Documentation() string {
 	docString := fmt.Sprintf("// %sSpec defines the desired state of %s.", r.Names.Original, r.Names.Original)
 	shape, ok := r.sdkAPI.API.Shapes[r.Names.Original]
-	if ok {
+	if ok && shape.Documentation != "" {
 		// Separate with a double newline to force a newline in the CRD base
-		docString += "\n//\n" + shape.Documentation
+		docString += "\n//\n" + commentBlock(
+			r.rewriteDocReferences(shape.Documentation), "",
+		)
 	}
 	return docString
 }
@@ -193,6 +203,9 @@ func (r *CRD) AddSpecField(
 	if fConfig != nil && fConfig.Print != nil {
 		r.addSpecPrintableColumn(f)
 	}
+	if f.IsJSON() {
+		r.AddTypeImport(jsonImportPath, jsonImportAlias)
+	}
 	r.SpecFields[memberNames.Original] = f
 	r.Fields[fPath] = f
 }
@@ -210,6 +223,9 @@ func (r *CRD) AddStatusField(
 	if fConfig != nil && fConfig.Print != nil {
 		r.addStatusPrintableColumn(f)
 	}
+	if f.IsJSON() {
+		r.AddTypeImport(jsonImportPath, jsonImportAlias)
+	}
 	r.StatusFields[memberNames.Original] = f
 	r.Fields[fPath] = f
 }
@@ -278,6 +294,9 @@ func (r *CRD) UnpackAttributes() {
 		fPath := fieldNames.Camel
 
 		f := NewField(r, fPath, fieldNames, nil, fieldConfig)
+		if f.IsJSON() {
+			r.AddTypeImport(jsonImportPath, jsonImportAlias)
+		}
 		if !fieldConfig.IsReadOnly {
 			r.SpecFields[fieldName] = f
 		} else {
@@ -317,6 +336,37 @@ func (r *CRD) IsSecretField(path string) bool {
 	return false
 }
 
+// IsJSONField returns true if the supplied field *path* refers to a Field
+// that is configured with `type: json`
+func (r *CRD) IsJSONField(path string) bool {
+	fConfigs := r.cfg.ResourceFields(r.Names.Original)
+	fConfig, found := fConfigs[path]
+	if found {
+		return fConfig.Type == fieldTypeJSON
+	}
+	return false
+}
+
+// HasJSONFields returns true if any of the resource's fields, including
+// nested fields, contains a JSON document
+func (r *CRD) HasJSONFields() bool {
+	for _, field := range r.Fields {
+		if field.IsJSON() {
+			return true
+		}
+	}
+	return false
+}
+
+// IsUnionShape returns true if the supplied shape is a union, i.e. a
+// structure where exactly one member may be set
+func (r *CRD) IsUnionShape(shape *awssdkmodel.Shape) bool {
+	if r.sdkAPI == nil {
+		return false
+	}
+	return r.sdkAPI.IsUnionShape(shape)
+}
+
 // GetImmutableFieldPaths returns list of immutable field paths present in CRD
 func (r *CRD) GetImmutableFieldPaths() []string {
 	fConfigs := r.cfg.ResourceFields(r.Names.Original)
@@ -509,6 +559,18 @@ func (r *CRD) UpdateConditionsCustomMethodName() string {
 
 // SpecIdentifierField returns the name of the "Name" or string identifier field in the Spec
 func (r *CRD) SpecIdentifierField() *string {
+	if r.IsSingleton() {
+		// A singleton resource is identified by the AWS account and region
+		return nil
+	}
+	if idFields := r.IdentifierFields(); len(idFields) > 0 {
+		// The first part of a composite identifier is the one that maps to
+		// the AWSIdentifiers.NameOrID field.
+		if _, found := r.SpecFields[idFields[0].Names.Original]; found {
+			return &idFields[0].Names.Camel
+		}
+		return nil
+	}
 	if r.cfg != nil {
 		rConfig, found := r.cfg.Resources[r.Names.Original]
 		if found {
@@ -532,12 +594,91 @@ func (r *CRD) SpecIdentifierField() *string {
 	return nil
 }
 
-// IsAdoptable returns true if the resource can be adopted
+// IdentifierFields returns the ordered list of Fields that, taken together,
+// uniquely identify the resource. The list is empty unless the generator
+// config marks one or more fields with `identifier_part`.
+func (r *CRD) IdentifierFields() []*Field {
+	fConfigs := r.cfg.ResourceFields(r.Names.Original)
+	fieldNames := []string{}
+	positions := map[string]int{}
+	for fName, fConfig := range fConfigs {
+		if fConfig == nil || fConfig.IdentifierPart == 0 {
+			continue
+		}
+		if r.IsSingleton() {
+			msg := fmt.Sprintf(
+				"GENERATION FAILURE! field %s of singleton resource %s "+
+					"cannot be an identifier part",
+				fName, r.Names.Original,
+			)
+			panic(msg)
+		}
+		if fConfig.IdentifierPart < 0 {
+			msg := fmt.Sprintf(
+				"GENERATION FAILURE! identifier_part for field %s of "+
+					"resource %s must be a positive integer, got %d",
+				fName, r.Names.Original, fConfig.IdentifierPart,
+			)
+			panic(msg)
+		}
+		for otherName, otherPos := range positions {
+			if otherPos == fConfig.IdentifierPart {
+				msg := fmt.Sprintf(
+					"GENERATION FAILURE! fields %s and %s of resource %s "+
+						"have the same identifier_part %d",
+					otherName, fName, r.Names.Original, otherPos,
+				)
+				panic(msg)
+			}
+		}
+		positions[fName] = fConfig.IdentifierPart
+		fieldNames = append(fieldNames, fName)
+	}
+	sort.Slice(fieldNames, func(i, j int) bool {
+		return positions[fieldNames[i]] < positions[fieldNames[j]]
+	})
+	res := make([]*Field, 0, len(fieldNames))
+	for _, fName := range fieldNames {
+		f, found := r.SpecFields[fName]
+		if !found {
+			f, found = r.StatusFields[fName]
+		}
+		if !found {
+			msg := fmt.Sprintf(
+				"GENERATION FAILURE! field %s of resource %s is marked as "+
+					"an identifier part but is not in the Spec or Status",
+				fName, r.Names.Original,
+			)
+			panic(msg)
+		}
+		res = append(res, f)
+	}
+	return res
+}
+
+// HasCompositeIdentifier returns true if the resource is identified by more
+// than one field
+func (r *CRD) HasCompositeIdentifier() bool {
+	return len(r.IdentifierFields()) > 1
+}
+
+// GeneratesTarget returns true if the resource is generated for the supplied
+// target, e.g. "controller"
+func (r *CRD) GeneratesTarget(target string) bool {
+	return r.cfg.ResourceGeneratesTarget(r.Names.Original, target)
+}
+
+// IsAdoptable returns true if the resource can be adopted. Resources with a
+// composite identifier can't be adopted since the AWSIdentifiers struct of
+// the ACK runtime only carries a single name or ID.
 func (r *CRD) IsAdoptable() bool {
 	if r.cfg == nil {
 		// Should never reach this condition
 		return false
 	}
+	if r.HasCompositeIdentifier() {
+		return false
+	}
 	return r.cfg.ResourceIsAdoptable(r.Names.Original)
 }
 
@@ -574,6 +715,62 @@ func (r *CRD) ReconcileRequeuOnSuccessSeconds() int {
 	return 0
 }
 
+// Readiness returns the ReadinessConfig describing the resource's lifecycle
+// status field. If none has been specified in the generator config, the
+// ReadinessConfig is derived from the resource's ready waiter, if any.
+func (r *CRD) Readiness() *ackgenconfig.ReadinessConfig {
+	if readiness := r.cfg.ResourceReadiness(r.Names.Original); readiness != nil {
+		return readiness
+	}
+	return r.waiterReadiness()
+}
+
+// FieldsAlongPath returns the Fields traversed by the supplied field path,
+// starting with the top-level Spec or Status field. The first element of the
+// path may be either the original SDK member name or the renamed field name.
+// Field paths that traverse list or map elements are not supported and
+// result in a nil return value.
+func (r *CRD) FieldsAlongPath(path string) []*Field {
+	parts := strings.Split(path, ".")
+	for _, part := range parts {
+		if part == "" {
+			return nil
+		}
+	}
+	top, found := r.SpecFields[parts[0]]
+	if !found {
+		top, found = r.StatusFields[parts[0]]
+	}
+	if !found {
+		top, found = r.Fields[names.New(parts[0]).Camel]
+	}
+	if !found {
+		return nil
+	}
+	res := []*Field{top}
+	fieldPath := top.Path
+	for _, part := range parts[1:] {
+		fieldPath += "." + names.New(part).Camel
+		f, found := r.Fields[fieldPath]
+		if !found {
+			return nil
+		}
+		res = append(res, f)
+	}
+	return res
+}
+
+// IsSpecField returns true if the supplied top-level Field is in the Spec of
+// the resource and false if it is in the Status
+func (r *CRD) IsSpecField(field *Field) bool {
+	for _, f := range r.SpecFields {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
 // CustomUpdateMethodName returns the name of the custom resourceManager method
 // for updating the resource state, if any has been specified in the generator
 // config
@@ -597,6 +794,33 @@ func (r *CRD) ListOpMatchFieldNames() []string {
 	return r.cfg.ListOpMatchFieldNames(r.Names.Original)
 }
 
+// Operation returns the API operation with the supplied name. The name is
+// either that of one of the resource's operations in `Ops`, e.g. "ReadOne", or
+// the name of any operation of the API, e.g. "ListTagsForResource". nil is
+// returned if there is no such operation.
+func (r *CRD) Operation(name string) *awssdkmodel.Operation {
+	switch name {
+	case "Create":
+		return r.Ops.Create
+	case "ReadOne":
+		return r.Ops.ReadOne
+	case "ReadMany":
+		return r.Ops.ReadMany
+	case "Update":
+		return r.Ops.Update
+	case "Delete":
+		return r.Ops.Delete
+	case "GetAttributes":
+		return r.Ops.GetAttributes
+	case "SetAttributes":
+		return r.Ops.SetAttributes
+	}
+	if r.sdkAPI == nil || r.sdkAPI.API == nil {
+		return nil
+	}
+	return r.sdkAPI.API.Operations[name]
+}
+
 // NewCRD returns a pointer to a new `ackmodel.CRD` struct that describes a
 // single top-level resource in an AWS service API
 func NewCRD(
@@ -0,0 +1,94 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package model
+
+import (
+	"strconv"
+	"strings"
+
+	awssdkmodel "github.com/aws/aws-sdk-go/private/model/api"
+)
+
+// DeprecatedField describes a field of a generated API type that the AWS API
+// model marks as deprecated
+type DeprecatedField struct {
+	// Path is the path to the field, e.g. "Bucket.Spec.GrantWrite" for a
+	// top-level field of a CRD or "LoggingEnabled.TargetGrants" for an
+	// attribute of a type definition
+	Path string
+	// Message is the API model's deprecation message for the field
+	Message string
+}
+
+// IsDeprecatedShapeRef returns true if the AWS API model marks the supplied
+// member, or the shape the member refers to, as deprecated
+func IsDeprecatedShapeRef(ref *awssdkmodel.ShapeRef) bool {
+	if ref == nil {
+		return false
+	}
+	return ref.Deprecated || (ref.Shape != nil && ref.Shape.Deprecated)
+}
+
+// deprecatedMessage returns the single-line deprecation message of the
+// supplied member, or a generic message mentioning the supplied field name if
+// the API model has none
+func deprecatedMessage(ref *awssdkmodel.ShapeRef, fieldName string) string {
+	msg := ref.DeprecatedMsg
+	if msg == "" && ref.Shape != nil {
+		msg = ref.Shape.DeprecatedMsg
+	}
+	if msg == "" {
+		return fieldName + " has been deprecated"
+	}
+	return strings.Join(strings.Fields(msg), " ")
+}
+
+// deprecationMarker returns the marker that flags a deprecated field in the
+// generated API types, e.g. `+ack:deprecated:warning="use Grants instead"`
+func deprecationMarker(msg string) string {
+	return "+ack:deprecated:warning=" + strconv.Quote(msg)
+}
+
+// IsDeprecated returns true if the AWS API model marks the field as deprecated
+func (f *Field) IsDeprecated() bool {
+	return IsDeprecatedShapeRef(f.ShapeRef)
+}
+
+// DeprecatedMessage returns the deprecation message of a deprecated field
+func (f *Field) DeprecatedMessage() string {
+	return deprecatedMessage(f.ShapeRef, f.Names.Camel)
+}
+
+// DeprecationMarker returns the deprecation warning marker of a deprecated
+// field
+func (f *Field) DeprecationMarker() string {
+	return deprecationMarker(f.DeprecatedMessage())
+}
+
+// IsDeprecated returns true if the AWS API model marks the attribute as
+// deprecated
+func (a *Attr) IsDeprecated() bool {
+	return IsDeprecatedShapeRef(a.ShapeRef)
+}
+
+// DeprecatedMessage returns the deprecation message of a deprecated attribute
+func (a *Attr) DeprecatedMessage() string {
+	return deprecatedMessage(a.ShapeRef, a.Names.Camel)
+}
+
+// DeprecationMarker returns the deprecation warning marker of a deprecated
+// attribute
+func (a *Attr) DeprecationMarker() string {
+	return deprecationMarker(a.DeprecatedMessage())
+}
@@ -0,0 +1,325 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package model
+
+import (
+	"encoding/json"
+	"html"
+	"io/ioutil"
+	"os"
+	"regexp"
+	"strings"
+
+	awssdkmodel "github.com/aws/aws-sdk-go/private/model/api"
+)
+
+// docWrapWidth is the maximum width of the text of a documentation comment
+// line, not counting the comment marker
+const docWrapWidth = 77
+
+var (
+	reDocTag        = regexp.MustCompile(`<(/?)([a-zA-Z][a-zA-Z0-9]*)([^>]*)>`)
+	reDocHref       = regexp.MustCompile(`href\s*=\s*"([^"]*)"`)
+	reDocWhitespace = regexp.MustCompile(`[\s\x{00a0}]+`)
+	reDocCodeSpan   = regexp.MustCompile("`\\s*([^`]*?)\\s*`")
+)
+
+// docBlock is a paragraph or list item of documentation text
+type docBlock struct {
+	text   strings.Builder
+	bullet bool
+}
+
+// HTMLToText converts the HTML documentation of the AWS API model, e.g.
+// `<p>The name of the <code>Bucket</code>.</p>`, into plain text. Paragraphs
+// are separated by an empty line, list items are prefixed with "* ", code is
+// quoted with backticks and links are followed by their URL in parentheses.
+// The returned text is not wrapped.
+func HTMLToText(doc string) string {
+	blocks := []*docBlock{}
+	var cur *docBlock
+	startBlock := func(bullet bool) {
+		cur = &docBlock{bullet: bullet}
+		blocks = append(blocks, cur)
+	}
+	appendText := func(text string) {
+		if cur == nil {
+			if strings.TrimSpace(text) == "" {
+				return
+			}
+			startBlock(false)
+		}
+		cur.text.WriteString(text)
+	}
+
+	// The content of these elements is not documentation of the shape
+	skipTag := ""
+	href := ""
+	pos := 0
+	for _, match := range reDocTag.FindAllStringSubmatchIndex(doc, -1) {
+		if skipTag == "" {
+			appendText(doc[pos:match[0]])
+		}
+		pos = match[1]
+		closing := doc[match[2]:match[3]] == "/"
+		tag := strings.ToLower(doc[match[4]:match[5]])
+		attrs := doc[match[6]:match[7]]
+		if skipTag != "" {
+			if closing && tag == skipTag {
+				skipTag = ""
+			}
+			continue
+		}
+		switch tag {
+		case "fullname", "examples", "example":
+			if !closing && !strings.HasSuffix(attrs, "/") {
+				skipTag = tag
+			}
+		case "li":
+			if closing {
+				cur = nil
+			} else {
+				startBlock(true)
+			}
+		case "p", "br", "ul", "ol", "dl", "dt", "dd", "div", "note",
+			"important", "h1", "h2", "h3", "h4", "h5", "h6":
+			// A paragraph at the start of a list item, e.g.
+			// `<li><p>MUTABLE</p></li>`, is the list item's text
+			if cur != nil && cur.bullet && strings.TrimSpace(cur.text.String()) == "" {
+				continue
+			}
+			cur = nil
+		case "code":
+			appendText("`")
+		case "a":
+			if closing {
+				if href != "" {
+					appendText(" (" + href + ")")
+				}
+				href = ""
+			} else if m := reDocHref.FindStringSubmatch(attrs); m != nil {
+				// Relative links and anchors are meaningless outside of the
+				// AWS documentation website
+				if strings.HasPrefix(m[1], "http://") || strings.HasPrefix(m[1], "https://") {
+					href = m[1]
+				}
+			}
+		}
+	}
+	if skipTag == "" {
+		appendText(doc[pos:])
+	}
+
+	lines := []string{}
+	prevBullet := false
+	for _, block := range blocks {
+		text := html.UnescapeString(block.text.String())
+		text = reDocWhitespace.ReplaceAllString(text, " ")
+		text = reDocCodeSpan.ReplaceAllString(text, "`$1`")
+		text = strings.TrimSpace(text)
+		if text == "" || text == "``" {
+			continue
+		}
+		if block.bullet {
+			text = "* " + text
+		}
+		// Consecutive list items are not separated by an empty line
+		if len(lines) > 0 && !(block.bullet && prevBullet) {
+			lines = append(lines, "")
+		}
+		lines = append(lines, text)
+		prevBullet = block.bullet
+	}
+	return strings.Join(lines, "\n")
+}
+
+// commentBlock returns the supplied documentation text as a block of Go
+// comment lines wrapped at docWrapWidth. All lines but the first are prefixed
+// with the supplied indentation so that the block can be rendered at the
+// indentation of its first line. The empty string is returned if the text is
+// empty.
+func commentBlock(text string, indent string) string {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return ""
+	}
+	out := []string{}
+	for _, line := range strings.Split(text, "\n") {
+		if strings.TrimSpace(line) == "" {
+			out = append(out, "//")
+			continue
+		}
+		contIndent := ""
+		if strings.HasPrefix(line, "* ") {
+			contIndent = "  "
+		}
+		for _, wrapped := range wrapDocLine(line, docWrapWidth, contIndent) {
+			out = append(out, "// "+wrapped)
+		}
+	}
+	return strings.Join(out, "\n"+indent)
+}
+
+// wrapDocLine splits the supplied line of text into lines no longer than the
+// supplied width, unless a single word is longer than that. Continuation
+// lines are prefixed with contIndent.
+func wrapDocLine(line string, width int, contIndent string) []string {
+	lines := []string{}
+	cur := ""
+	for _, word := range strings.Fields(line) {
+		if cur == "" {
+			cur = word
+			continue
+		}
+		if len(cur)+1+len(word) > width {
+			lines = append(lines, cur)
+			cur = contIndent + word
+			continue
+		}
+		cur += " " + word
+	}
+	if cur != "" {
+		lines = append(lines, cur)
+	}
+	return lines
+}
+
+// rewriteDocReferences replaces the names of renamed fields quoted as code in
+// the supplied documentation text, e.g. "`Bucket`" in the S3 Bucket's
+// documentation, with the names used in the generated API types, e.g.
+// "`Name`".
+//
+// Type renames are not applied because a shape renamed to
+// avoid a collision with a CRD, e.g. ECR's Repository shape, is most often
+// referred to as the resource itself.
+func (r *CRD) rewriteDocReferences(text string) string {
+	renames := map[string]string{}
+	for _, fields := range []map[string]*Field{r.SpecFields, r.StatusFields} {
+		for _, field := range fields {
+			original := field.Names.ModelOriginal
+			if original != "" && original != field.Names.Camel {
+				renames[original] = field.Names.Camel
+			}
+		}
+	}
+	if len(renames) == 0 {
+		return text
+	}
+	return reDocCodeSpan.ReplaceAllStringFunc(text, func(span string) string {
+		name := strings.Trim(span, "` ")
+		if renamed, found := renames[name]; found {
+			return "`" + renamed + "`"
+		}
+		return span
+	})
+}
+
+// Documentation returns the field's documentation as a block of Go comment
+// lines, or the empty string if the field is undocumented. The
+// documentation from the AWS API model may be replaced or appended to by the
+// field's `documentation` configuration.
+func (f *Field) Documentation() string {
+	doc := ""
+	if f.ShapeRef != nil {
+		doc = f.ShapeRef.Documentation
+	}
+	if f.FieldConfig != nil && f.FieldConfig.Documentation != nil {
+		docConfig := f.FieldConfig.Documentation
+		if docConfig.Override != "" {
+			doc = docConfig.Override
+		}
+		if docConfig.Append != "" {
+			if doc != "" {
+				doc += "\n\n"
+			}
+			doc += docConfig.Append
+		}
+	}
+	if f.CRD != nil {
+		doc = f.CRD.rewriteDocReferences(doc)
+	}
+	return commentBlock(doc, "\t")
+}
+
+// Documentation returns the attribute's documentation as a block of Go
+// comment lines, or the empty string if the attribute is undocumented
+func (a *Attr) Documentation() string {
+	doc := ""
+	if a.ShapeRef != nil {
+		doc = a.ShapeRef.Documentation
+	}
+	if doc == "" && a.Shape != nil {
+		doc = a.Shape.Documentation
+	}
+	return commentBlock(doc, "\t")
+}
+
+// Documentation returns the type definition's documentation as a block of Go
+// comment lines, or the empty string if the type is undocumented
+func (td *TypeDef) Documentation() string {
+	if td.Shape == nil {
+		return ""
+	}
+	return commentBlock(td.Shape.Documentation, "")
+}
+
+// attachPlainTextDocs replaces the documentation that aws-sdk-go attaches to
+// the shapes and shape members of the supplied API, which is already
+// formatted as Go comments, with the plain text conversion of the HTML
+// documentation in the supplied `docs-2.json` file
+func attachPlainTextDocs(api *awssdkmodel.API, docsPath string) error {
+	b, err := ioutil.ReadFile(docsPath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	docs := struct {
+		Shapes map[string]struct {
+			Base string            `json:"base"`
+			Refs map[string]string `json:"refs"`
+		} `json:"shapes"`
+	}{}
+	if err = json.Unmarshal(b, &docs); err != nil {
+		return err
+	}
+	// The references are keyed by "<shape name>$<member name>". aws-sdk-go
+	// exports the member names, e.g. "string" becomes "String_", so we match
+	// them case-insensitively
+	refDocs := map[string]string{}
+	for _, shapeDoc := range docs.Shapes {
+		for ref, doc := range shapeDoc.Refs {
+			parts := strings.Split(ref, "$")
+			if len(parts) != 2 || doc == "" {
+				continue
+			}
+			refDocs[parts[0]+"$"+strings.ToLower(parts[1])] = doc
+		}
+	}
+	for _, shape := range api.Shapes {
+		// aws-sdk-go renames some shapes, e.g. CreateBucketRequest becomes
+		// CreateBucketInput
+		shapeName := shape.OrigShapeName
+		if shapeName == "" {
+			shapeName = shape.ShapeName
+		}
+		shape.Documentation = HTMLToText(docs.Shapes[shapeName].Base)
+		for memberName, memberRef := range shape.MemberRefs {
+			key := shapeName + "$" + strings.ToLower(strings.TrimSuffix(memberName, "_"))
+			memberRef.Documentation = HTMLToText(refDocs[key])
+		}
+	}
+	return nil
+}
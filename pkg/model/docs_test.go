@@ -0,0 +1,65 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package model_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
+)
+
+func TestHTMLToText(t *testing.T) {
+	assert := assert.New(t)
+	testCases := []struct {
+		name string
+		doc  string
+		want string
+	}{
+		{
+			"empty",
+			"",
+			"",
+		},
+		{
+			"paragraphs and code",
+			"<p>The name of the <code> Bucket </code>.</p> <p>Must be\n   unique.</p>",
+			"The name of the `Bucket`.\n\nMust be unique.",
+		},
+		{
+			"list items",
+			"<p>One of:</p><ul><li><p><code>MUTABLE</code></p></li><li>IMMUTABLE</li></ul><p>Default is MUTABLE.</p>",
+			"One of:\n\n* `MUTABLE`\n* IMMUTABLE\n\nDefault is MUTABLE.",
+		},
+		{
+			"links",
+			`<p>See <a href="https://docs.aws.amazon.com/x.html">Policies</a> and <a href="#anchor">below</a>.</p>`,
+			"See Policies (https://docs.aws.amazon.com/x.html) and below.",
+		},
+		{
+			"entities and skipped elements",
+			"<fullname>Amazon Thing</fullname><p>Format: &lt;cluster&gt;:&lt;service&gt; &amp; more</p><examples><p>ignored</p></examples>",
+			"Format: <cluster>:<service> & more",
+		},
+		{
+			"notes are paragraphs",
+			"<p>Text.</p><note><p>Careful.</p></note>",
+			"Text.\n\nCareful.",
+		},
+	}
+	for _, tc := range testCases {
+		assert.Equal(tc.want, model.HTMLToText(tc.doc), tc.name)
+	}
+}
@@ -15,10 +15,20 @@ package model
 
 import (
 	"bytes"
+	"regexp"
+	"strconv"
+	"strings"
 
+	awssdkmodel "github.com/aws/aws-sdk-go/private/model/api"
+
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/generate/config"
 	"github.com/aws-controllers-k8s/code-generator/pkg/names"
 )
 
+// markerBareValue matches enum values that can appear unquoted in a
+// kubebuilder marker without being mistaken for a number or a separator
+var markerBareValue = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
 type EnumValue struct {
 	Original string
 	Clean    string
@@ -41,6 +51,41 @@ func NewEnumDef(names names.Names, values []string) (*EnumDef, error) {
 	return &EnumDef{names, enumVals}, nil
 }
 
+// NewEnumDefForShape returns a pointer to an `ackmodel.EnumDef` struct for the
+// supplied string shape, or nil if the shape's value is not constrained to
+// specific string values
+func NewEnumDefForShape(
+	api *SDKAPI,
+	cfg *ackgenconfig.Config,
+	shape *awssdkmodel.Shape,
+) (*EnumDef, error) {
+	if shape == nil || !shape.IsEnum() {
+		return nil, nil
+	}
+	enumNames := names.New(shape.ShapeName)
+	// Handle name conflicts with top-level CRD.Spec or CRD.Status
+	// types
+	if api != nil && api.HasConflictingTypeName(shape.ShapeName, cfg) {
+		enumNames.Camel += ConflictingNameSuffix
+	}
+	return NewEnumDef(enumNames, shape.Enum)
+}
+
+// ValidationMarker returns the kubebuilder marker restricting a string field
+// to the enumeration's values, e.g.
+// `+kubebuilder:validation:Enum=COMPLETE;FAILED;"in-progress"`
+func (e *EnumDef) ValidationMarker() string {
+	values := make([]string, len(e.Values))
+	for x, val := range e.Values {
+		if markerBareValue.MatchString(val.Original) {
+			values[x] = val.Original
+		} else {
+			values[x] = strconv.Quote(val.Original)
+		}
+	}
+	return "+kubebuilder:validation:Enum=" + strings.Join(values, ";")
+}
+
 func newEnumVal(orig string) EnumValue {
 	// Convert values like "m5.xlarge" into "m5_xlarge"
 	cleaner := func(r rune) rune {
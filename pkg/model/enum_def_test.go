@@ -0,0 +1,41 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package model_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
+	"github.com/aws-controllers-k8s/code-generator/pkg/names"
+)
+
+func TestEnumDef_ValidationMarker(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	edef, err := model.NewEnumDef(
+		names.New("GatewayType"),
+		[]string{"ipsec.1", "on-demand", "STANDARD_IA", "2"},
+	)
+	require.Nil(err)
+
+	// Values that could be mistaken for numbers or separators are quoted
+	assert.Equal(
+		`+kubebuilder:validation:Enum="ipsec.1";"on-demand";STANDARD_IA;"2"`,
+		edef.ValidationMarker(),
+	)
+}
@@ -14,6 +14,7 @@
 package model
 
 import (
+	"fmt"
 	"strings"
 
 	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/generate/config"
@@ -22,6 +23,20 @@ import (
 	awssdkmodel "github.com/aws/aws-sdk-go/private/model/api"
 )
 
+const (
+	// fieldTypeJSON is the FieldConfig.Type of fields containing a JSON
+	// document
+	fieldTypeJSON = "json"
+	// JSONGoType is the Go type of fields containing a JSON document
+	JSONGoType = "*apiextensionsv1.JSON"
+	// jsonImportPath is the package path of the Go type of fields containing
+	// a JSON document
+	jsonImportPath = "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	// jsonImportAlias is the alias of the package of the Go type of fields
+	// containing a JSON document
+	jsonImportAlias = "apiextensionsv1"
+)
+
 // Field represents a single field in the CRD's Spec or Status objects. The
 // field may be a direct field of the Spec or Status object or may be a field
 // of a list or struct-type field of the Spec or Status object. We call these
@@ -47,6 +62,9 @@ type Field struct {
 	GoTypeWithPkgName string
 	ShapeRef          *awssdkmodel.ShapeRef
 	FieldConfig       *ackgenconfig.FieldConfig
+	// EnumDef is the enumeration the field's string value is constrained
+	// to, or nil if the field is not an enum-backed string field
+	EnumDef *EnumDef
 }
 
 // IsRequired checks the FieldConfig for Field and returns if the field is
@@ -60,7 +78,40 @@ func (f *Field) IsRequired() bool {
 	if f.FieldConfig != nil && f.FieldConfig.IsRequired != nil {
 		return *f.FieldConfig.IsRequired
 	}
-	return util.InStrings(f.Names.ModelOriginal, f.CRD.Ops.Create.InputRef.Shape.Required)
+	// The parent identifier of a child resource may be supplied using a
+	// reference to the parent custom resource instead
+	if f.CRD.IsParentField(f.Names.Original) {
+		return false
+	}
+	inputShape := f.CRD.Ops.Create.InputRef.Shape
+	// Fields promoted from an unwrapped Input shape member are required if
+	// the wrapper shape says so
+	if wrapperName := f.CRD.UnwrappedInputMember(); wrapperName != "" {
+		wrapperShape := inputShape.MemberRefs[wrapperName].Shape
+		if _, found := wrapperShape.MemberRefs[f.Names.ModelOriginal]; found {
+			return util.InStrings(f.Names.ModelOriginal, wrapperShape.Required)
+		}
+	}
+	return util.InStrings(f.Names.ModelOriginal, inputShape.Required)
+}
+
+// IsJSON returns true if the field contains a JSON document, either because
+// the field's shape is an aws-sdk-go `aws.JSONValue` or because the field is
+// configured with `type: json` in the generator config
+func (f *Field) IsJSON() bool {
+	if f.ShapeRef != nil && f.ShapeRef.JSONValue {
+		return true
+	}
+	return f.FieldConfig != nil && f.FieldConfig.Type == fieldTypeJSON
+}
+
+// IsUnion returns true if the field is a struct where exactly one member may
+// be set
+func (f *Field) IsUnion() bool {
+	if f.ShapeRef == nil {
+		return false
+	}
+	return f.CRD.IsUnionShape(f.ShapeRef.Shape)
 }
 
 // ParentFieldPath takes a field path and returns the field path of the
@@ -95,13 +146,32 @@ func NewField(
 		shape = shapeRef.Shape
 	}
 
+	var enumDef *EnumDef
 	if shape != nil {
-		gte, gt, gtwp = cleanGoType(crd.sdkAPI, crd.cfg, shape, cfg)
+		gte, gt, gtwp = CleanGoType(crd.sdkAPI, crd.cfg, shape, cfg)
+		if cfg == nil || !cfg.IsSecret {
+			var err error
+			enumDef, err = NewEnumDefForShape(crd.sdkAPI, crd.cfg, shape)
+			if err != nil {
+				msg := fmt.Sprintf(
+					"GENERATION FAILURE! unable to build enum for field %s "+
+						"of resource %s: %v",
+					path, crd.Names.Original, err,
+				)
+				panic(msg)
+			}
+		}
 	} else {
 		gte = "string"
 		gt = "*string"
 		gtwp = "*string"
 	}
+	if isJSONField(crd, path, shapeRef, cfg) {
+		gte = "JSON"
+		gt = JSONGoType
+		gtwp = JSONGoType
+		enumDef = nil
+	}
 	return &Field{
 		CRD:               crd,
 		Names:             fieldNames,
@@ -111,5 +181,44 @@ func NewField(
 		GoTypeElem:        gte,
 		GoTypeWithPkgName: gtwp,
 		FieldConfig:       cfg,
+		EnumDef:           enumDef,
+	}
+}
+
+// isJSONField returns true if the field at the supplied path contains a JSON
+// document, panicking if the field is configured with an unsupported type
+func isJSONField(
+	crd *CRD,
+	path string,
+	shapeRef *awssdkmodel.ShapeRef,
+	cfg *ackgenconfig.FieldConfig,
+) bool {
+	if cfg == nil || cfg.Type == "" {
+		return shapeRef != nil && shapeRef.JSONValue
+	}
+	if cfg.Type != fieldTypeJSON {
+		msg := fmt.Sprintf(
+			"GENERATION FAILURE! field %s of resource %s has unsupported "+
+				"type %s. The only supported type is %s",
+			path, crd.Names.Original, cfg.Type, fieldTypeJSON,
+		)
+		panic(msg)
+	}
+	if strings.Contains(path, ".") {
+		msg := fmt.Sprintf(
+			"GENERATION FAILURE! field %s of resource %s has type %s but "+
+				"only top-level fields may be configured with a type",
+			path, crd.Names.Original, cfg.Type,
+		)
+		panic(msg)
+	}
+	if shapeRef != nil && shapeRef.Shape != nil && shapeRef.Shape.Type != "string" {
+		msg := fmt.Sprintf(
+			"GENERATION FAILURE! field %s of resource %s has type %s but "+
+				"its shape is a %s, not a string",
+			path, crd.Names.Original, cfg.Type, shapeRef.Shape.Type,
+		)
+		panic(msg)
 	}
+	return true
 }
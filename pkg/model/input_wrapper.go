@@ -0,0 +1,84 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package model
+
+import (
+	"fmt"
+
+	awssdkmodel "github.com/aws/aws-sdk-go/private/model/api"
+)
+
+// UnwrappedInputMember returns the name of the Create operation's Input shape
+// member whose members are promoted to top-level Spec fields, or the empty
+// string if the resource's Input shape is not unwrapped.
+//
+// Unless configured otherwise, an Input shape with a single member that is a
+// structure, e.g. CloudFront's `CreateDistributionInput{DistributionConfig}`,
+// is unwrapped.
+func (r *CRD) UnwrappedInputMember() string {
+	if r.Ops.Create == nil || r.Ops.Create.InputRef.Shape == nil {
+		return ""
+	}
+	inputShape := r.Ops.Create.InputRef.Shape
+	memberName, configured := r.cfg.ResourceUnwrapInput(r.Names.Original)
+	if !configured {
+		// The AccountId member of a singleton resource's Input shape, e.g.
+		// S3 Control's `PutPublicAccessBlockRequest{AccountId,
+		// PublicAccessBlockConfiguration}`, is not a field of the resource
+		candidates := []string{}
+		for name := range inputShape.MemberRefs {
+			if !r.IsSingletonAccountIDField(name) {
+				candidates = append(candidates, name)
+			}
+		}
+		if len(candidates) != 1 {
+			return ""
+		}
+		memberRef := inputShape.MemberRefs[candidates[0]]
+		if memberRef.Shape != nil && memberRef.Shape.Type == "structure" {
+			return candidates[0]
+		}
+		return ""
+	}
+	if memberName == "" {
+		return ""
+	}
+	memberRef, found := inputShape.MemberRefs[memberName]
+	if !found || memberRef.Shape == nil || memberRef.Shape.Type != "structure" {
+		msg := fmt.Sprintf(
+			"GENERATION FAILURE! unwrap_input %s of resource %s is not a "+
+				"structure member of the %s Input shape",
+			memberName, r.Names.Original, r.Ops.Create.Name,
+		)
+		panic(msg)
+	}
+	return memberName
+}
+
+// IsUnwrappedInputMember returns true if the supplied member of an Input or
+// Output shape is the Create operation's unwrapped Input shape member, e.g.
+// the DistributionConfig member of CloudFront's UpdateDistributionInput
+// shape. The members of such a member are top-level Spec fields.
+func (r *CRD) IsUnwrappedInputMember(
+	memberName string,
+	memberRef *awssdkmodel.ShapeRef,
+) bool {
+	wrapperName := r.UnwrappedInputMember()
+	if wrapperName == "" || memberName != wrapperName || memberRef == nil {
+		return false
+	}
+	wrapperRef := r.Ops.Create.InputRef.Shape.MemberRefs[wrapperName]
+	return memberRef.Shape != nil &&
+		memberRef.Shape.ShapeName == wrapperRef.Shape.ShapeName
+}
@@ -0,0 +1,281 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package model
+
+import (
+	"fmt"
+	"strings"
+)
+
+// markerKind describes the arguments that a kubebuilder marker takes
+type markerKind int
+
+const (
+	// markerFlag markers take no argument, e.g.
+	// `+kubebuilder:validation:Required`
+	markerFlag markerKind = iota
+	// markerValue markers take a single argument, e.g.
+	// `+kubebuilder:validation:MinLength=2`
+	markerValue
+	// markerArgs markers take named arguments, e.g.
+	// `+kubebuilder:resource:shortName=repo,scope=Cluster`
+	markerArgs
+)
+
+// markerDef describes a kubebuilder marker known to the version of
+// controller-gen that generates the CRDs, see scripts/install-controller-gen.sh
+type markerDef struct {
+	kind markerKind
+	// args are the names of the arguments of a markerArgs marker
+	args []string
+}
+
+// fieldMarkers are the kubebuilder markers that may be set on a field
+var fieldMarkers = map[string]markerDef{
+	"kubebuilder:default":                           {kind: markerValue},
+	"kubebuilder:pruning:PreserveUnknownFields":     {kind: markerFlag},
+	"kubebuilder:validation:EmbeddedResource":       {kind: markerFlag},
+	"kubebuilder:validation:Enum":                   {kind: markerValue},
+	"kubebuilder:validation:ExclusiveMaximum":       {kind: markerValue},
+	"kubebuilder:validation:ExclusiveMinimum":       {kind: markerValue},
+	"kubebuilder:validation:Format":                 {kind: markerValue},
+	"kubebuilder:validation:MaxItems":               {kind: markerValue},
+	"kubebuilder:validation:MaxLength":              {kind: markerValue},
+	"kubebuilder:validation:MaxProperties":          {kind: markerValue},
+	"kubebuilder:validation:Maximum":                {kind: markerValue},
+	"kubebuilder:validation:MinItems":               {kind: markerValue},
+	"kubebuilder:validation:MinLength":              {kind: markerValue},
+	"kubebuilder:validation:MinProperties":          {kind: markerValue},
+	"kubebuilder:validation:Minimum":                {kind: markerValue},
+	"kubebuilder:validation:MultipleOf":             {kind: markerValue},
+	"kubebuilder:validation:Optional":               {kind: markerFlag},
+	"kubebuilder:validation:Pattern":                {kind: markerValue},
+	"kubebuilder:validation:Required":               {kind: markerFlag},
+	"kubebuilder:validation:Schemaless":             {kind: markerFlag},
+	"kubebuilder:validation:Type":                   {kind: markerValue},
+	"kubebuilder:validation:UniqueItems":            {kind: markerValue},
+	"kubebuilder:validation:XEmbeddedResource":      {kind: markerFlag},
+	"kubebuilder:validation:XIntOrString":           {kind: markerFlag},
+	"kubebuilder:validation:XPreserveUnknownFields": {kind: markerFlag},
+	"kubebuilder:validation:XValidation": {kind: markerArgs, args: []string{
+		"message", "rule",
+	}},
+	"listMapKey": {kind: markerValue},
+	"listType":   {kind: markerValue},
+	"mapType":    {kind: markerValue},
+	"nullable":   {kind: markerFlag},
+	"optional":   {kind: markerFlag},
+	"structType": {kind: markerValue},
+}
+
+// typeMarkers are the kubebuilder markers that may be set on the Go type of
+// a CRD
+var typeMarkers = map[string]markerDef{
+	"kubebuilder:deprecatedversion": {kind: markerArgs, args: []string{"warning"}},
+	"kubebuilder:printcolumn": {kind: markerArgs, args: []string{
+		"JSONPath", "description", "format", "name", "priority", "type",
+	}},
+	"kubebuilder:resource": {kind: markerArgs, args: []string{
+		"categories", "path", "scope", "shortName", "singular",
+	}},
+	"kubebuilder:skipversion":    {kind: markerFlag},
+	"kubebuilder:storageversion": {kind: markerFlag},
+	"kubebuilder:subresource:scale": {kind: markerArgs, args: []string{
+		"selectorpath", "specpath", "statuspath",
+	}},
+	"kubebuilder:subresource:status": {kind: markerFlag},
+	"kubebuilder:unservedversion":    {kind: markerFlag},
+}
+
+// ValidateFieldMarker returns an error if the supplied marker, e.g.
+// `+kubebuilder:validation:MinLength=2`, is not a well-formed kubebuilder
+// marker that may be set on a field
+func ValidateFieldMarker(marker string) error {
+	return validateMarker(marker, fieldMarkers)
+}
+
+// ValidateTypeMarker returns an error if the supplied marker, e.g.
+// `+kubebuilder:storageversion`, is not a well-formed kubebuilder marker that
+// may be set on the Go type of a CRD
+func ValidateTypeMarker(marker string) error {
+	return validateMarker(marker, typeMarkers)
+}
+
+// validateMarker returns an error if the supplied marker is not one of the
+// supplied known markers or its arguments are malformed
+func validateMarker(marker string, known map[string]markerDef) error {
+	if !strings.HasPrefix(marker, "+") {
+		return fmt.Errorf("marker %q must start with \"+\"", marker)
+	}
+	body := strings.TrimPrefix(marker, "+")
+	// Markers with named arguments separate the arguments from the marker
+	// name with a colon, just like the parts of the name itself
+	for name, def := range known {
+		if def.kind == markerArgs && strings.HasPrefix(body, name+":") {
+			return validateMarkerArgs(
+				marker, strings.TrimPrefix(body, name+":"), def.args,
+			)
+		}
+	}
+	name := body
+	value := ""
+	hasValue := false
+	if eq := strings.Index(body, "="); eq >= 0 {
+		name, value, hasValue = body[:eq], body[eq+1:], true
+	}
+	def, found := known[name]
+	if !found {
+		return fmt.Errorf("unknown marker %q", marker)
+	}
+	switch def.kind {
+	case markerFlag:
+		if hasValue {
+			return fmt.Errorf("marker %q does not take a value", marker)
+		}
+	case markerValue:
+		if value == "" {
+			return fmt.Errorf("marker %q requires a value", marker)
+		}
+		if _, err := splitMarkerArgs(value); err != nil {
+			return fmt.Errorf("marker %q: %v", marker, err)
+		}
+	case markerArgs:
+		return fmt.Errorf("marker %q requires arguments", marker)
+	}
+	return nil
+}
+
+// validateMarkerArgs returns an error if the supplied named arguments of a
+// marker are malformed or not one of the supplied known argument names
+func validateMarkerArgs(marker string, argsStr string, known []string) error {
+	args, err := splitMarkerArgs(argsStr)
+	if err != nil {
+		return fmt.Errorf("marker %q: %v", marker, err)
+	}
+	for _, arg := range args {
+		parts := strings.SplitN(arg, "=", 2)
+		found := false
+		for _, name := range known {
+			if parts[0] == name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf(
+				"marker %q has unknown argument %q, expected one of %v",
+				marker, parts[0], known,
+			)
+		}
+		if len(parts) == 2 && parts[1] == "" {
+			return fmt.Errorf(
+				"marker %q requires a value for argument %q", marker, parts[0],
+			)
+		}
+	}
+	return nil
+}
+
+// splitMarkerArgs splits the supplied marker arguments on the commas that are
+// not within quotes or braces and returns an error if the quotes or braces
+// are unbalanced
+func splitMarkerArgs(argsStr string) ([]string, error) {
+	args := []string{}
+	depth := 0
+	var quote rune
+	start := 0
+	escaped := false
+	for x, c := range argsStr {
+		switch {
+		case escaped:
+			escaped = false
+		case quote != 0:
+			if c == '\\' && quote == '"' {
+				escaped = true
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '`':
+			quote = c
+		case c == '{':
+			depth++
+		case c == '}':
+			depth--
+			if depth < 0 {
+				return nil, fmt.Errorf("unbalanced braces in %q", argsStr)
+			}
+		case c == ',' && depth == 0:
+			args = append(args, argsStr[start:x])
+			start = x + 1
+		}
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated quote in %q", argsStr)
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("unbalanced braces in %q", argsStr)
+	}
+	return append(args, argsStr[start:]), nil
+}
+
+// Markers returns the kubebuilder markers for the CRD's Go type other than
+// the `+kubebuilder:resource` marker returned by ResourceMarker, e.g.
+// `+kubebuilder:storageversion`
+func (r *CRD) Markers() []string {
+	rConfig, found := r.cfg.ResourceConfig(r.Names.Original)
+	if !found {
+		return nil
+	}
+	markers := []string{}
+	if rConfig.StorageVersion {
+		markers = append(markers, "+kubebuilder:storageversion")
+	}
+	for _, marker := range rConfig.Markers {
+		if err := ValidateTypeMarker(marker); err != nil {
+			msg := fmt.Sprintf(
+				"GENERATION FAILURE! invalid marker for resource %s: %v",
+				r.Names.Original, err,
+			)
+			panic(msg)
+		}
+		markers = append(markers, marker)
+	}
+	return markers
+}
+
+// Markers returns the kubebuilder markers configured for the field, e.g.
+// `+kubebuilder:validation:XPreserveUnknownFields`
+func (f *Field) Markers() []string {
+	if f.FieldConfig == nil {
+		return nil
+	}
+	markers := []string{}
+	if f.FieldConfig.PreserveUnknownFields {
+		markers = append(
+			markers, "+kubebuilder:validation:XPreserveUnknownFields",
+		)
+	}
+	for _, marker := range f.FieldConfig.Markers {
+		if err := ValidateFieldMarker(marker); err != nil {
+			msg := fmt.Sprintf(
+				"GENERATION FAILURE! invalid marker for field %s of "+
+					"resource %s: %v",
+				f.Path, f.CRD.Names.Original, err,
+			)
+			panic(msg)
+		}
+		markers = append(markers, marker)
+	}
+	return markers
+}
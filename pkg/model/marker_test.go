@@ -0,0 +1,143 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package model_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
+)
+
+func TestValidateMarkers(t *testing.T) {
+	assert := assert.New(t)
+	testCases := []struct {
+		name      string
+		marker    string
+		validate  func(string) error
+		wantError bool
+	}{
+		{
+			"field flag",
+			"+kubebuilder:validation:Required",
+			model.ValidateFieldMarker,
+			false,
+		},
+		{
+			"field value",
+			"+kubebuilder:validation:MinLength=2",
+			model.ValidateFieldMarker,
+			false,
+		},
+		{
+			"field value with braces",
+			`+kubebuilder:default={"a": 1, "b": "c,d"}`,
+			model.ValidateFieldMarker,
+			false,
+		},
+		{
+			"field args",
+			`+kubebuilder:validation:XValidation:rule="self.size() > 2",message="too short"`,
+			model.ValidateFieldMarker,
+			false,
+		},
+		{
+			"missing plus",
+			"kubebuilder:validation:Required",
+			model.ValidateFieldMarker,
+			true,
+		},
+		{
+			"unknown field marker",
+			"+kubebuilder:validation:MinLen=2",
+			model.ValidateFieldMarker,
+			true,
+		},
+		{
+			"type marker on a field",
+			"+kubebuilder:storageversion",
+			model.ValidateFieldMarker,
+			true,
+		},
+		{
+			"flag with value",
+			"+kubebuilder:validation:Required=true",
+			model.ValidateFieldMarker,
+			true,
+		},
+		{
+			"missing value",
+			"+kubebuilder:validation:MinLength=",
+			model.ValidateFieldMarker,
+			true,
+		},
+		{
+			"unterminated quote",
+			`+kubebuilder:validation:Pattern="^a`,
+			model.ValidateFieldMarker,
+			true,
+		},
+		{
+			"type flag",
+			"+kubebuilder:storageversion",
+			model.ValidateTypeMarker,
+			false,
+		},
+		{
+			"type args",
+			`+kubebuilder:printcolumn:name="Age",type=date,JSONPath=".metadata.creationTimestamp"`,
+			model.ValidateTypeMarker,
+			false,
+		},
+		{
+			"unknown type argument",
+			"+kubebuilder:resource:shortNames=repo",
+			model.ValidateTypeMarker,
+			true,
+		},
+		{
+			"type argument without value",
+			"+kubebuilder:resource:scope=",
+			model.ValidateTypeMarker,
+			true,
+		},
+		{
+			"type marker without arguments",
+			"+kubebuilder:printcolumn",
+			model.ValidateTypeMarker,
+			true,
+		},
+		{
+			"type marker unknown to controller-gen v0.9.2",
+			`+kubebuilder:metadata:labels="app=ecr"`,
+			model.ValidateTypeMarker,
+			true,
+		},
+		{
+			"unbalanced braces",
+			"+kubebuilder:deprecatedversion:warning={",
+			model.ValidateTypeMarker,
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		err := tc.validate(tc.marker)
+		if tc.wantError {
+			assert.NotNil(err, tc.name)
+		} else {
+			assert.Nil(err, tc.name)
+		}
+	}
+}
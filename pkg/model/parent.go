@@ -0,0 +1,192 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package model
+
+import (
+	"fmt"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/names"
+)
+
+// SetParent links the CRD to the CRD of its parent resource. The supplied
+// parent must be the resource named in the `parent` block of the generator
+// config for this resource.
+func (r *CRD) SetParent(parent *CRD) {
+	parentConfig := r.cfg.ResourceParent(r.Names.Original)
+	if parentConfig == nil {
+		msg := fmt.Sprintf(
+			"GENERATION FAILURE! resource %s is not configured with a parent",
+			r.Names.Original,
+		)
+		panic(msg)
+	}
+	if parent.Names.Original != parentConfig.Resource {
+		msg := fmt.Sprintf(
+			"GENERATION FAILURE! resource %s has parent %s, not %s",
+			r.Names.Original, parentConfig.Resource, parent.Names.Original,
+		)
+		panic(msg)
+	}
+	r.parent = parent
+	parent.children = append(parent.children, r)
+
+	childField := r.ParentField()
+	parentField := r.ParentIdentifierField()
+	if childField.GoType != parentField.GoType {
+		msg := fmt.Sprintf(
+			"GENERATION FAILURE! field %s of resource %s has type %s but "+
+				"parent field %s of resource %s has type %s",
+			childField.Names.Original, r.Names.Original, childField.GoType,
+			parentField.Names.Original, parent.Names.Original,
+			parentField.GoType,
+		)
+		panic(msg)
+	}
+	r.addParentPrintableColumn(childField)
+}
+
+// HasParent returns true if the resource only exists within the context of
+// a parent resource
+func (r *CRD) HasParent() bool {
+	return r.parent != nil
+}
+
+// Parent returns the CRD of the parent resource or nil if the resource is
+// not a child resource
+func (r *CRD) Parent() *CRD {
+	return r.parent
+}
+
+// HasChildren returns true if other resources only exist within the context
+// of the resource
+func (r *CRD) HasChildren() bool {
+	return len(r.children) > 0
+}
+
+// Children returns the CRDs of the resources that only exist within the
+// context of the resource
+func (r *CRD) Children() []*CRD {
+	return r.children
+}
+
+// UsesKubeClient returns true if the resource manager of the resource reads
+// or patches related custom resources, i.e. the resource's parent or
+// children, and so needs a Kubernetes API client
+func (r *CRD) UsesKubeClient() bool {
+	return r.HasParent() || r.HasChildren()
+}
+
+// IsParentField returns true if the supplied field name is the name of the
+// field that carries the parent resource's identifier
+func (r *CRD) IsParentField(fieldName string) bool {
+	parentConfig := r.cfg.ResourceParent(r.Names.Original)
+	if parentConfig == nil {
+		return false
+	}
+	return parentConfig.Field == fieldName
+}
+
+// ParentField returns the Spec field of the resource that carries the
+// parent resource's identifier
+func (r *CRD) ParentField() *Field {
+	parentConfig := r.cfg.ResourceParent(r.Names.Original)
+	if parentConfig == nil {
+		return nil
+	}
+	f, found := r.SpecFields[parentConfig.Field]
+	if !found {
+		msg := fmt.Sprintf(
+			"GENERATION FAILURE! parent field %s is not a Spec field of "+
+				"resource %s",
+			parentConfig.Field, r.Names.Original,
+		)
+		panic(msg)
+	}
+	return f
+}
+
+// ParentIdentifierField returns the field of the parent resource that
+// contains the identifier copied into the resource's parent field
+func (r *CRD) ParentIdentifierField() *Field {
+	if r.parent == nil {
+		return nil
+	}
+	fieldName := r.parentIdentifierFieldName()
+	if f, found := r.parent.SpecFields[fieldName]; found {
+		return f
+	}
+	if f, found := r.parent.StatusFields[fieldName]; found {
+		return f
+	}
+	msg := fmt.Sprintf(
+		"GENERATION FAILURE! parent resource %s of resource %s has no "+
+			"field %s",
+		r.parent.Names.Original, r.Names.Original, fieldName,
+	)
+	panic(msg)
+}
+
+// ParentIdentifierFieldPath returns the Go path, relative to the parent
+// custom resource, of the field that contains the parent's identifier, e.g.
+// "Status.APIID"
+func (r *CRD) ParentIdentifierFieldPath() string {
+	f := r.ParentIdentifierField()
+	if f == nil {
+		return ""
+	}
+	if _, found := r.parent.SpecFields[f.Names.Original]; found {
+		return "Spec." + f.Names.Camel
+	}
+	return "Status." + f.Names.Camel
+}
+
+// ParentRefFieldNames returns the names of the Spec field that holds a
+// reference to the parent custom resource, e.g. "APIRef"
+func (r *CRD) ParentRefFieldNames() *names.Names {
+	if r.parent == nil {
+		return nil
+	}
+	refNames := names.New(r.parent.Names.Original + "Ref")
+	return &refNames
+}
+
+func (r *CRD) parentIdentifierFieldName() string {
+	parentConfig := r.cfg.ResourceParent(r.Names.Original)
+	if parentConfig.ParentField != "" {
+		return parentConfig.ParentField
+	}
+	return parentConfig.Field
+}
+
+// addParentPrintableColumn adds an entry to the list of additional printer
+// columns showing the parent resource's identifier, unless the generator
+// config already prints the parent field
+func (r *CRD) addParentPrintableColumn(
+	field *Field,
+) {
+	if field.FieldConfig != nil && field.FieldConfig.Print != nil {
+		return
+	}
+	if field.GoTypeElem != "string" {
+		return
+	}
+	column := &PrinterColumn{
+		CRD:      r,
+		Name:     r.parent.Kind,
+		Type:     "string",
+		Priority: 0,
+		JSONPath: fmt.Sprintf("%s.%s", ".spec", field.Names.CamelLower),
+	}
+	r.additionalPrinterColumns = append(r.additionalPrinterColumns, column)
+}
@@ -0,0 +1,116 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package model
+
+import (
+	"fmt"
+	"strings"
+
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/generate/config"
+)
+
+// singletonAccountIDMemberName is the name of the Input shape member that
+// identifies the AWS account of a singleton resource, e.g. the AccountId
+// member of the S3 Control PutPublicAccessBlockRequest shape
+const singletonAccountIDMemberName = "AccountId"
+
+// Scope returns the scope of the resource's CRD, either "Namespaced" or
+// "Cluster"
+func (r *CRD) Scope() string {
+	scope := r.cfg.ResourceScope(r.Names.Original)
+	switch scope {
+	case ackgenconfig.ResourceScopeNamespaced, ackgenconfig.ResourceScopeCluster:
+		return scope
+	}
+	msg := fmt.Sprintf(
+		"GENERATION FAILURE! scope %s of resource %s must be either %s or %s",
+		scope, r.Names.Original, ackgenconfig.ResourceScopeNamespaced,
+		ackgenconfig.ResourceScopeCluster,
+	)
+	panic(msg)
+}
+
+// IsClusterScoped returns true if the resource's custom resources are global
+// to the Kubernetes cluster instead of living in a Namespace
+func (r *CRD) IsClusterScoped() bool {
+	return r.Scope() == ackgenconfig.ResourceScopeCluster
+}
+
+// IsSingleton returns true if there is exactly one of the resource per AWS
+// account and region. A singleton resource has no identifier field, so it
+// must be cluster-scoped: a Namespaced CRD would allow one custom resource
+// per Namespace, all of them managing the same AWS resource.
+func (r *CRD) IsSingleton() bool {
+	if !r.cfg.ResourceIsSingleton(r.Names.Original) {
+		return false
+	}
+	if !r.IsClusterScoped() {
+		msg := fmt.Sprintf(
+			"GENERATION FAILURE! singleton resource %s must have scope %s",
+			r.Names.Original, ackgenconfig.ResourceScopeCluster,
+		)
+		panic(msg)
+	}
+	return true
+}
+
+// IsSingletonAccountIDField returns true if the supplied Input shape member
+// identifies the AWS account of a singleton resource. Such a member is not a
+// field of the resource and is set to the AWS account ID of the resource
+// manager instead.
+func (r *CRD) IsSingletonAccountIDField(memberName string) bool {
+	return r.IsSingleton() && memberName == singletonAccountIDMemberName
+}
+
+// ResourceMarker returns the kubebuilder marker that sets the short names,
+// categories and scope of the resource's CRD, e.g.
+// `+kubebuilder:resource:shortName=pab,scope=Cluster`, or the empty string if
+// the CRD has neither short names, categories nor a cluster scope
+func (r *CRD) ResourceMarker() string {
+	args := []string{}
+	if len(r.ShortNames) > 0 {
+		args = append(args, "shortName="+strings.Join(r.ShortNames, ";"))
+	}
+	if categories := r.Categories(); len(categories) > 0 {
+		args = append(args, "categories="+strings.Join(categories, ";"))
+	}
+	if r.IsClusterScoped() {
+		args = append(args, "scope="+ackgenconfig.ResourceScopeCluster)
+	}
+	if len(args) == 0 {
+		return ""
+	}
+	return "+kubebuilder:resource:" + strings.Join(args, ",")
+}
+
+// Categories returns the categories configured for the resource's CRD, e.g.
+// "aws-all"
+func (r *CRD) Categories() []string {
+	return r.cfg.ResourceCategories(r.Names.Original)
+}
+
+// CRDMetadata returns the labels and annotations configured for the
+// CustomResourceDefinition object of the resource, or nil if there are none.
+// controller-gen has no marker for them, so they are set by a kustomize
+// patch of the generated CRD.
+func (r *CRD) CRDMetadata() *ackgenconfig.CRDMetadataConfig {
+	rConfig, found := r.cfg.ResourceConfig(r.Names.Original)
+	if !found || rConfig.Metadata == nil {
+		return nil
+	}
+	if len(rConfig.Metadata.Labels) == 0 && len(rConfig.Metadata.Annotations) == 0 {
+		return nil
+	}
+	return rConfig.Metadata
+}
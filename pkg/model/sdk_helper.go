@@ -14,6 +14,7 @@
 package model
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -59,9 +60,15 @@ func NewSDKHelper(basePath string) *SDKHelper {
 	}
 }
 
-// API returns the aws-sdk-go API model for a supplied service alias
+// API returns the aws-sdk-go API model for a supplied service alias.
+//
+// Any `waiters-2.json` file found next to the service's `api-2.json` file is
+// attached to the returned API model by the aws-sdk-go model loader.
+//
+// The aws-sdk-go model loader does not keep the `union` trait of shapes, so
+// the `api-2.json` file is read again to find the union shapes of the API.
 func (h *SDKHelper) API(serviceAlias string) (*SDKAPI, error) {
-	modelPath, _, err := h.ModelAndDocsPath(serviceAlias)
+	modelPath, docsPath, err := h.ModelAndDocsPath(serviceAlias)
 	if err != nil {
 		return nil, err
 	}
@@ -79,11 +86,42 @@ func (h *SDKHelper) API(serviceAlias string) (*SDKAPI, error) {
 		// Calling API.ServicePackageDoc() ends up resetting the API.imports
 		// unexported map variable...
 		_ = api.ServicePackageDoc()
-		return &SDKAPI{api, nil, nil, h.APIGroupSuffix}, nil
+		unionShapes, err := loadUnionShapes(modelPath)
+		if err != nil {
+			return nil, err
+		}
+		if err = attachPlainTextDocs(api, docsPath); err != nil {
+			return nil, err
+		}
+		return &SDKAPI{api, nil, nil, h.APIGroupSuffix, unionShapes}, nil
 	}
 	return nil, ErrServiceNotFound
 }
 
+// loadUnionShapes returns a map, keyed by shape name, of the shapes marked
+// with `"union": true` in the supplied `api-2.json` model file
+func loadUnionShapes(modelPath string) (map[string]bool, error) {
+	b, err := ioutil.ReadFile(modelPath)
+	if err != nil {
+		return nil, err
+	}
+	model := struct {
+		Shapes map[string]struct {
+			Union bool `json:"union"`
+		} `json:"shapes"`
+	}{}
+	if err = json.Unmarshal(b, &model); err != nil {
+		return nil, err
+	}
+	res := map[string]bool{}
+	for shapeName, shape := range model.Shapes {
+		if shape.Union {
+			res[shapeName] = true
+		}
+	}
+	return res, nil
+}
+
 // ModelAndDocsPath returns two string paths to the supplied service alias'
 // model and doc JSON files
 func (h *SDKHelper) ModelAndDocsPath(
@@ -136,6 +174,9 @@ type SDKAPI struct {
 	typeRenames map[string]string
 	// Default is "services.k8s.aws"
 	apiGroupSuffix string
+	// Map, keyed by shape name, of the shapes that are unions, i.e.
+	// structures where exactly one member may be set
+	unionShapes map[string]bool
 }
 
 // GetPayloads returns a slice of strings of Shape names representing input and
@@ -149,6 +190,27 @@ func (a *SDKAPI) GetPayloads() []string {
 	return res
 }
 
+// GetWaiters returns the waiters defined in the API's `waiters-2.json` model
+// file that poll the supplied operation, sorted by waiter name
+func (a *SDKAPI) GetWaiters(opName string) []*awssdkmodel.Waiter {
+	res := []*awssdkmodel.Waiter{}
+	for x, waiter := range a.API.Waiters {
+		if waiter.OperationName == opName {
+			res = append(res, &a.API.Waiters[x])
+		}
+	}
+	return res
+}
+
+// IsUnionShape returns true if the supplied shape is a union, i.e. a
+// structure where exactly one member may be set
+func (a *SDKAPI) IsUnionShape(shape *awssdkmodel.Shape) bool {
+	if shape == nil || shape.Type != "structure" {
+		return false
+	}
+	return a.unionShapes[shape.ShapeName]
+}
+
 // GetOperationMap returns a map, keyed by the operation type and operation
 // ID/name, of aws-sdk-go private/model/api.Operation struct pointers
 func (a *SDKAPI) GetOperationMap(cfg *ackgenconfig.Config) *OperationMap {
@@ -337,6 +399,15 @@ func (a *SDKAPI) SDKAPIInterfaceTypeName() string {
 func getOpTypeAndResourceName(opID string, cfg *ackgenconfig.Config) (OpType, string) {
 	opType, resName := GetOpTypeAndResourceNameFromOpID(opID)
 
+	// The Put operation of a singleton resource, e.g. PutRegistryPolicy,
+	// creates the resource if it does not exist yet
+	if opType == OpTypeUnknown && strings.HasPrefix(opID, "Put") {
+		singletonName := strings.TrimPrefix(opID, "Put")
+		if cfg.ResourceIsSingleton(singletonName) {
+			opType, resName = OpTypeCreate, singletonName
+		}
+	}
+
 	if cfg != nil {
 		if operationConfig, exists := cfg.Operations[opID]; exists {
 			if operationConfig.OperationType != "" {
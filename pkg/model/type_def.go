@@ -14,6 +14,10 @@
 package model
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+
 	awssdkmodel "github.com/aws/aws-sdk-go/private/model/api"
 
 	"github.com/aws-controllers-k8s/code-generator/pkg/names"
@@ -32,4 +36,53 @@ type TypeDef struct {
 	Names names.Names
 	Attrs map[string]*Attr
 	Shape *awssdkmodel.Shape
+	// IsUnion is true if exactly one of the struct's attributes may be set
+	IsUnion bool
+	// ValidateUnion is true if the generator config opts in to validating
+	// union structs with a CEL rule, using `union_validation`
+	ValidateUnion bool
+}
+
+// UnionValidationMarker returns the kubebuilder marker adding a CEL validation
+// rule that requires exactly one of the struct's attributes to be set, e.g.
+//
+// +kubebuilder:validation:XValidation:rule="(has(self.a) ? 1 : 0) + (has(self.b) ? 1 : 0) == 1",message="exactly one of a, b must be set"
+//
+// The empty string is returned if the struct is not a union or the generator
+// config doesn't set `union_validation`, since the XValidation marker requires
+// controller-gen v0.9.0 or later and Kubernetes 1.25 or later.
+func (td *TypeDef) UnionValidationMarker() string {
+	if !td.IsUnion || !td.ValidateUnion || len(td.Attrs) == 0 {
+		return ""
+	}
+	attrNames := []string{}
+	for _, attr := range td.Attrs {
+		attrNames = append(attrNames, attr.Names.CamelLower)
+	}
+	sort.Strings(attrNames)
+	terms := []string{}
+	for _, attrName := range attrNames {
+		terms = append(terms, fmt.Sprintf("(has(self.%s) ? 1 : 0)", attrName))
+	}
+	return fmt.Sprintf(
+		"+kubebuilder:validation:XValidation:rule=%q,message=%q",
+		strings.Join(terms, " + ")+" == 1",
+		"exactly one of "+strings.Join(attrNames, ", ")+" must be set",
+	)
+}
+
+// TypeDefImports returns a map, keyed by an import string, with the map value
+// being an optional alias, of the packages that the Go types of the supplied
+// TypeDefs' attributes belong to, other than the packages every API types file
+// imports
+func TypeDefImports(tdefs []*TypeDef) map[string]string {
+	res := map[string]string{}
+	for _, tdef := range tdefs {
+		for _, attr := range tdef.Attrs {
+			if attr.IsJSON() {
+				res[jsonImportPath] = jsonImportAlias
+			}
+		}
+	}
+	return res
 }
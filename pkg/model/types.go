@@ -22,10 +22,14 @@ import (
 	"github.com/aws-controllers-k8s/code-generator/pkg/names"
 )
 
-// cleanGoType returns a tuple of three strings representing the normalized Go
+// CleanGoType returns a tuple of three strings representing the normalized Go
 // types in "element", "normal" and "with package name" format for a particular
 // Shape.
-func cleanGoType(
+//
+// Lists and maps are normalized recursively, so any combination of list, map
+// and structure element types, e.g. a map of lists of structures, results in
+// a Go type referring to the cleaned-up Camel-cased type names.
+func CleanGoType(
 	api *SDKAPI,
 	cfg *ackgenconfig.Config,
 	shape *awssdkmodel.Shape,
@@ -43,21 +47,39 @@ func cleanGoType(
 		cleanNames := names.New(gte)
 		gte = cleanNames.Camel
 		if api.HasConflictingTypeName(gte, cfg) {
-			gte += "_SDK"
+			gte += ConflictingNameSuffix
 		}
 		gt = "*" + gte
+		// Replace the type part of the full type-with-package-name with the
+		// cleaned up type name
+		typeParts := strings.Split(gtwp, ".")
+		if len(typeParts) == 2 {
+			gtwp = typeParts[0] + "." + gte
+		}
 	} else if shape.Type == "list" {
 		// If it's a list type, where the element is a structure, we need to
 		// set the GoType to the cleaned-up Camel-cased name
-		mgte, mgt, mgtwp := cleanGoType(api, cfg, shape.MemberRef.Shape, fieldCfg)
+		mgte, mgt, mgtwp := CleanGoType(api, cfg, shape.MemberRef.Shape, fieldCfg)
 		cleanNames := names.New(mgte)
 		gte = cleanNames.Camel
 		if api.HasConflictingTypeName(mgte, cfg) {
-			gte += "_SDK"
+			gte += ConflictingNameSuffix
 		}
 
 		gt = "[]" + mgt
 		gtwp = "[]" + mgtwp
+	} else if shape.Type == "map" {
+		// Likewise for map types, where the value element may be a structure
+		// or another list or map. Map keys are always strings.
+		vgte, vgt, vgtwp := CleanGoType(api, cfg, shape.ValueRef.Shape, fieldCfg)
+		cleanNames := names.New(vgte)
+		gte = cleanNames.Camel
+		if api.HasConflictingTypeName(vgte, cfg) {
+			gte += ConflictingNameSuffix
+		}
+
+		gt = "map[string]" + vgt
+		gtwp = "map[string]" + vgtwp
 	} else if shape.Type == "timestamp" {
 		// time.Time needs to be converted to apimachinery/metav1.Time
 		// otherwise there is no DeepCopy support
@@ -68,14 +90,6 @@ func cleanGoType(
 		gt = "*ackv1alpha1.SecretKeyReference"
 		gte = "SecretKeyReference"
 		gtwp = "*ackv1alpha1.SecretKeyReference"
-		return gte, gt, gtwp
-	}
-
-	// Replace the type part of the full type-with-package-name with the
-	// cleaned up type name
-	typeParts := strings.Split(gtwp, ".")
-	if len(typeParts) == 2 {
-		gtwp = typeParts[0] + "." + gte
 	}
 	return gte, gt, gtwp
 }
@@ -117,18 +131,20 @@ func ReplacePkgName(
 	keepPointer bool,
 ) string {
 	memberType := subject
-	sliceDepth := 0 // Depth of the slice type
-	isSliceType := strings.HasPrefix(memberType, "[]")
-	if isSliceType {
-		sliceDepth = strings.LastIndex(subject, "[]")/2 + 1
-		memberType = memberType[sliceDepth*2:]
-	}
-	mapDepth := 0 // Depth of the map type
-	// Assuming the map keys are always of type string.
-	isMapType := strings.HasPrefix(memberType, "map[string]")
-	if isMapType {
-		mapDepth = strings.LastIndex(subject, "map[string]")/11 + 1
-		memberType = memberType[mapDepth*11:]
+	// Strip any combination of slice and map prefixes, e.g. the
+	// "map[string][]" in "map[string][]*ecr.Repository". We assume map keys
+	// are always of type string.
+	containerPrefix := ""
+	for {
+		if strings.HasPrefix(memberType, "[]") {
+			containerPrefix += "[]"
+			memberType = memberType[2:]
+		} else if strings.HasPrefix(memberType, "map[string]") {
+			containerPrefix += "map[string]"
+			memberType = memberType[11:]
+		} else {
+			break
+		}
 	}
 	isPointerType := strings.HasPrefix(memberType, "*")
 	if isPointerType {
@@ -149,11 +165,5 @@ func ReplacePkgName(
 	if isPointerType && keepPointer {
 		memberType = "*" + memberType
 	}
-	if isMapType {
-		memberType = strings.Repeat("map[string]", mapDepth) + memberType
-	}
-	if isSliceType {
-		memberType = strings.Repeat("[]", sliceDepth) + memberType
-	}
-	return memberType
+	return containerPrefix + memberType
 }
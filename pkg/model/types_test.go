@@ -66,6 +66,20 @@ func TestReplacePkgName(t *testing.T) {
 			true,
 			"[][]*svcsdk.EC2TagFilter",
 		},
+		{ // map of slices type
+			"map[string][]*codedeploy.EC2TagFilter",
+			"codedeploy",
+			"svcsdk",
+			true,
+			"map[string][]*svcsdk.EC2TagFilter",
+		},
+		{ // slice of maps type
+			"[]map[string]*dynamodb.AttributeValue",
+			"dynamodb",
+			"svcapitypes",
+			false,
+			"[]map[string]svcapitypes.AttributeValue",
+		},
 	}
 
 	for _, tc := range testCases {
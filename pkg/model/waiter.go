@@ -0,0 +1,330 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package model
+
+import (
+	"fmt"
+	"strings"
+
+	awssdkmodel "github.com/aws/aws-sdk-go/private/model/api"
+
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/generate/config"
+	"github.com/aws-controllers-k8s/code-generator/pkg/util"
+)
+
+const (
+	waiterStateSuccess = "success"
+	waiterStateFailure = "failure"
+	waiterStateRetry   = "retry"
+
+	waiterMatcherError = "error"
+)
+
+// Waiters returns the aws-sdk-go waiters that poll the operation used to
+// read the resource's latest observed state, sorted by waiter name
+func (r *CRD) Waiters() []*awssdkmodel.Waiter {
+	if r.sdkAPI == nil {
+		return nil
+	}
+	if waitersConfig := r.cfg.ResourceWaiters(r.Names.Original); waitersConfig != nil && waitersConfig.Ignore {
+		return nil
+	}
+	var readOp *awssdkmodel.Operation
+	switch {
+	case r.Ops.ReadOne != nil:
+		readOp = r.Ops.ReadOne
+	case r.Ops.GetAttributes != nil:
+		readOp = r.Ops.GetAttributes
+	case r.Ops.ReadMany != nil:
+		readOp = r.Ops.ReadMany
+	default:
+		return nil
+	}
+	return r.sdkAPI.GetWaiters(readOp.Name)
+}
+
+// ReadyWaiter returns the aws-sdk-go waiter that polls the resource's read
+// operation until the resource is available, or nil if there is no such
+// waiter.
+//
+// Unless a waiter is named in the generator config, the ready waiter is the
+// first waiter with a success acceptor matching on a string field of the
+// resource that is not also a deletion waiter.
+func (r *CRD) ReadyWaiter() *awssdkmodel.Waiter {
+	waiters := r.Waiters()
+	if name := r.configuredWaiterName(false); name != "" {
+		return r.waiterByName(waiters, name)
+	}
+	for _, waiter := range waiters {
+		if isDeletionWaiter(waiter) {
+			continue
+		}
+		if r.waiterFieldPath(waiter) != "" {
+			return waiter
+		}
+	}
+	return nil
+}
+
+// DeletionWaiter returns the aws-sdk-go waiter that polls the resource's
+// read operation until the resource has been deleted, or nil if there is no
+// such waiter.
+//
+// Unless a waiter is named in the generator config, the deletion waiter is
+// the first waiter with a success acceptor matching on an error code, e.g.
+// DynamoDB's `TableNotExists` waiter.
+func (r *CRD) DeletionWaiter() *awssdkmodel.Waiter {
+	waiters := r.Waiters()
+	if name := r.configuredWaiterName(true); name != "" {
+		return r.waiterByName(waiters, name)
+	}
+	for _, waiter := range waiters {
+		if isDeletionWaiter(waiter) {
+			return waiter
+		}
+	}
+	return nil
+}
+
+// DeletedLifecycleValues returns the values of the resource's lifecycle
+// status field that the deletion waiter considers to mean the resource has
+// been deleted, e.g. "deleted"
+func (r *CRD) DeletedLifecycleValues() []string {
+	waiter := r.DeletionWaiter()
+	readiness := r.Readiness()
+	if waiter == nil || readiness == nil {
+		return nil
+	}
+	res := []string{}
+	for _, acceptor := range waiter.Acceptors {
+		if acceptor.State != waiterStateSuccess {
+			continue
+		}
+		value, ok := acceptor.Expected.(string)
+		if !ok {
+			continue
+		}
+		if r.waiterArgumentFieldPath(acceptor) == readiness.Path {
+			res = append(res, value)
+		}
+	}
+	return res
+}
+
+// waiterReadiness returns a ReadinessConfig built from the acceptors of the
+// resource's ready waiter, or nil if the resource has no ready waiter.
+// Acceptors with a "success" state give the Ready values, "failure" state
+// the Failed values and "retry" state the InProgress values. Values matched
+// by more than one acceptor are only listed for the first one.
+//
+// The Deleting values are the values that the resource's deletion waiter
+// matches with a "success" or "retry" acceptor, e.g. "deleted" or
+// "deleting". A ready waiter failing on a value the deletion waiter doesn't
+// know about, e.g. "DELETE_FAILED", gives a Failed value. Use the
+// `readiness` block of the generator config to list other Deleting values.
+func (r *CRD) waiterReadiness() *ackgenconfig.ReadinessConfig {
+	waiter := r.ReadyWaiter()
+	if waiter == nil {
+		return nil
+	}
+	path := r.waiterFieldPath(waiter)
+	if path == "" {
+		msg := fmt.Sprintf(
+			"GENERATION FAILURE! waiter %s does not match on a string "+
+				"field of resource %s",
+			waiter.Name, r.Names.Original,
+		)
+		panic(msg)
+	}
+	res := &ackgenconfig.ReadinessConfig{Path: path}
+	deletionValues := r.deletionWaiterLifecycleValues(path)
+	seen := map[string]bool{}
+	for _, acceptor := range waiter.Acceptors {
+		value, ok := acceptor.Expected.(string)
+		if !ok || r.waiterArgumentFieldPath(acceptor) != path {
+			continue
+		}
+		// A waiter stops at the first matching acceptor, so a value matched
+		// by more than one acceptor takes the state of the first one
+		if seen[value] {
+			continue
+		}
+		seen[value] = true
+		switch acceptor.State {
+		case waiterStateSuccess:
+			res.Ready = append(res.Ready, value)
+		case waiterStateFailure:
+			// Ready waiters fail once the resource is being deleted, but a
+			// resource being deleted hasn't failed
+			if util.InStrings(value, deletionValues) {
+				res.Deleting = append(res.Deleting, value)
+			} else {
+				res.Failed = append(res.Failed, value)
+			}
+		case waiterStateRetry:
+			res.InProgress = append(res.InProgress, value)
+		}
+	}
+	for _, value := range deletionValues {
+		if !seen[value] {
+			res.Deleting = append(res.Deleting, value)
+		}
+	}
+	return res
+}
+
+// deletionWaiterLifecycleValues returns the values of the resource field
+// with the supplied path that the resource's deletion waiter matches with a
+// "success" or "retry" acceptor, i.e. the values meaning the resource has
+// been, or is being, deleted
+func (r *CRD) deletionWaiterLifecycleValues(path string) []string {
+	waiter := r.DeletionWaiter()
+	if waiter == nil {
+		return nil
+	}
+	res := []string{}
+	for _, acceptor := range waiter.Acceptors {
+		if acceptor.State != waiterStateSuccess &&
+			acceptor.State != waiterStateRetry {
+			continue
+		}
+		value, ok := acceptor.Expected.(string)
+		if !ok || util.InStrings(value, res) {
+			continue
+		}
+		if r.waiterArgumentFieldPath(acceptor) == path {
+			res = append(res, value)
+		}
+	}
+	return res
+}
+
+// DeletingLifecycleValues returns the values of the resource's lifecycle
+// status field that mean the resource is being deleted, e.g. "deleting",
+// excluding any value that DeletedLifecycleValues returns
+func (r *CRD) DeletingLifecycleValues() []string {
+	readiness := r.Readiness()
+	if readiness == nil {
+		return nil
+	}
+	deleted := map[string]bool{}
+	for _, value := range r.DeletedLifecycleValues() {
+		deleted[value] = true
+	}
+	res := []string{}
+	for _, value := range readiness.Deleting {
+		if !deleted[value] {
+			res = append(res, value)
+		}
+	}
+	return res
+}
+
+// configuredWaiterName returns the name of the ready (or deletion) waiter
+// named in the generator config for the resource, if any
+func (r *CRD) configuredWaiterName(deletion bool) string {
+	waitersConfig := r.cfg.ResourceWaiters(r.Names.Original)
+	if waitersConfig == nil {
+		return ""
+	}
+	if deletion {
+		return waitersConfig.Deleted
+	}
+	return waitersConfig.Ready
+}
+
+// waiterByName returns the waiter with the supplied name, panicking if the
+// waiter does not poll the resource's read operation
+func (r *CRD) waiterByName(
+	waiters []*awssdkmodel.Waiter,
+	name string,
+) *awssdkmodel.Waiter {
+	for _, waiter := range waiters {
+		if waiter.Name == name {
+			return waiter
+		}
+	}
+	msg := fmt.Sprintf(
+		"GENERATION FAILURE! waiter %s does not exist or does not poll "+
+			"the read operation of resource %s",
+		name, r.Names.Original,
+	)
+	panic(msg)
+}
+
+// waiterFieldPath returns the path of the resource field that the first
+// success acceptor of the supplied waiter matches on, or the empty string if
+// there is no such acceptor
+func (r *CRD) waiterFieldPath(waiter *awssdkmodel.Waiter) string {
+	for _, acceptor := range waiter.Acceptors {
+		if acceptor.State != waiterStateSuccess {
+			continue
+		}
+		if _, ok := acceptor.Expected.(string); !ok {
+			continue
+		}
+		if path := r.waiterArgumentFieldPath(acceptor); path != "" {
+			return path
+		}
+	}
+	return ""
+}
+
+// waiterArgumentFieldPath returns the path of the string field of the
+// resource that the supplied acceptor's argument refers to, or the empty
+// string if the argument does not refer to such a field.
+//
+// Acceptor arguments are JMESPath expressions against the read operation's
+// Output shape, e.g. "Table.TableStatus" or "DBInstances[].DBInstanceStatus".
+// Leading wrapper members that have no corresponding resource field are
+// stripped, so the above arguments map to the "TableStatus" and
+// "DBInstanceStatus" fields. Expressions using functions or comparisons are
+// not supported.
+func (r *CRD) waiterArgumentFieldPath(acceptor awssdkmodel.WaiterAcceptor) string {
+	if acceptor.Matcher == waiterMatcherError || acceptor.Argument == "" {
+		return ""
+	}
+	if strings.ContainsAny(acceptor.Argument, "()=<>!|&`' ") {
+		return ""
+	}
+	parts := strings.Split(acceptor.Argument, ".")
+	for x, part := range parts {
+		parts[x] = strings.TrimSuffix(strings.TrimSuffix(part, "[]"), "[*]")
+	}
+	for x := range parts {
+		path := strings.Join(parts[x:], ".")
+		fields := r.FieldsAlongPath(path)
+		if len(fields) == 0 {
+			continue
+		}
+		if fields[len(fields)-1].GoType != "*string" {
+			return ""
+		}
+		return path
+	}
+	return ""
+}
+
+// isDeletionWaiter returns true if the supplied waiter succeeds when the
+// polled operation returns an error, which is how aws-sdk-go waiters express
+// that a resource no longer exists
+func isDeletionWaiter(waiter *awssdkmodel.Waiter) bool {
+	for _, acceptor := range waiter.Acceptors {
+		if acceptor.State == waiterStateSuccess &&
+			acceptor.Matcher == waiterMatcherError {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,149 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package testutil
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// goldenFileSuffix is appended to the paths of golden files so that golden Go
+// files aren't mistaken for source files
+const goldenFileSuffix = ".golden"
+
+// update is set by running tests with `-update` and makes AssertGoldenFiles
+// refresh the golden files instead of comparing against them
+var update = flag.Bool(
+	"update", false, "refresh golden files with the generated output",
+)
+
+// AssertGoldenFiles fails the test if the supplied generated files, by path,
+// differ from the golden files in the supplied directory, printing a diff for
+// each file that differs. Golden files that weren't generated and generated
+// files with no golden file also fail the test.
+//
+// When tests are run with the `-update` flag, the golden directory is
+// instead replaced with the generated files.
+func AssertGoldenFiles(
+	t *testing.T,
+	goldenDir string,
+	files map[string]*bytes.Buffer,
+) {
+	t.Helper()
+	if *update {
+		if err := writeGoldenFiles(goldenDir, files); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	goldens, err := readGoldenFiles(goldenDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	paths := []string{}
+	for path := range files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		golden, found := goldens[path]
+		if !found {
+			t.Errorf("%s: generated file has no golden file, run with -update to add it", path)
+			continue
+		}
+		if diff := goldenDiff(path, golden, files[path].String()); diff != "" {
+			t.Errorf("%s: generated file differs from golden file:\n%s", path, diff)
+		}
+	}
+	goldenPaths := []string{}
+	for path := range goldens {
+		goldenPaths = append(goldenPaths, path)
+	}
+	sort.Strings(goldenPaths)
+	for _, path := range goldenPaths {
+		if _, found := files[path]; !found {
+			t.Errorf("%s: golden file was not generated, run with -update to remove it", path)
+		}
+	}
+}
+
+// goldenDiff returns a unified diff between the supplied golden and
+// generated contents of a file, or the empty string if they're equal
+func goldenDiff(path string, golden string, generated string) string {
+	if golden == generated {
+		return ""
+	}
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(golden),
+		B:        difflib.SplitLines(generated),
+		FromFile: filepath.Join("golden", path),
+		ToFile:   filepath.Join("generated", path),
+		Context:  3,
+	})
+	if err != nil {
+		return err.Error()
+	}
+	return diff
+}
+
+// readGoldenFiles returns the contents of the golden files in the supplied
+// directory, by the path of the generated file they're the golden file for
+func readGoldenFiles(goldenDir string) (map[string]string, error) {
+	res := map[string]string{}
+	if _, err := os.Stat(goldenDir); os.IsNotExist(err) {
+		return res, nil
+	}
+	err := filepath.Walk(goldenDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(goldenDir, path)
+		if err != nil {
+			return err
+		}
+		contents, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		res[strings.TrimSuffix(rel, goldenFileSuffix)] = string(contents)
+		return nil
+	})
+	return res, err
+}
+
+// writeGoldenFiles replaces the supplied golden directory with golden files
+// for the supplied generated files
+func writeGoldenFiles(goldenDir string, files map[string]*bytes.Buffer) error {
+	if err := os.RemoveAll(goldenDir); err != nil {
+		return err
+	}
+	for path, contents := range files {
+		goldenPath := filepath.Join(goldenDir, path+goldenFileSuffix)
+		if err := os.MkdirAll(filepath.Dir(goldenPath), os.ModePerm); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(goldenPath, contents.Bytes(), 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package testutil_test
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	ackgenerate "github.com/aws-controllers-k8s/code-generator/pkg/generate/ack"
+	cpgenerate "github.com/aws-controllers-k8s/code-generator/pkg/generate/crossplane"
+	olmgenerate "github.com/aws-controllers-k8s/code-generator/pkg/generate/olm"
+	"github.com/aws-controllers-k8s/code-generator/pkg/generate/templateset"
+	"github.com/aws-controllers-k8s/code-generator/pkg/testutil"
+)
+
+var (
+	// templateBasePaths contains the paths to the templates, relative to this
+	// package
+	templateBasePaths = []string{"../../templates"}
+	// createdAtRegexp matches the creation timestamp of OLM bundles, which is
+	// the time the bundle assets were generated
+	createdAtRegexp = regexp.MustCompile(`createdAt: .*`)
+)
+
+// goldenTarget is a generator target whose output is compared against golden
+// files
+type goldenTarget struct {
+	name        string
+	templateSet func(t *testing.T, svcAlias string) (*templateset.TemplateSet, error)
+}
+
+var goldenTargets = []goldenTarget{
+	{
+		"apis",
+		func(t *testing.T, svcAlias string) (*templateset.TemplateSet, error) {
+			g := testutil.NewGeneratorForService(t, svcAlias)
+			return ackgenerate.APIs(g, templateBasePaths)
+		},
+	},
+	{
+		"controller",
+		func(t *testing.T, svcAlias string) (*templateset.TemplateSet, error) {
+			g := testutil.NewGeneratorForService(t, svcAlias)
+			return ackgenerate.Controller(g, templateBasePaths)
+		},
+	},
+	{
+		"release",
+		func(t *testing.T, svcAlias string) (*templateset.TemplateSet, error) {
+			g := testutil.NewGeneratorForService(t, svcAlias)
+			return ackgenerate.Release(
+				g, templateBasePaths, "v0.0.0",
+				"public.ecr.aws/aws-controllers-k8s/controller",
+				fmt.Sprintf("ack-%s-controller", svcAlias),
+			)
+		},
+	},
+	{
+		"olm",
+		func(t *testing.T, svcAlias string) (*templateset.TemplateSet, error) {
+			g := testutil.NewGeneratorForService(t, svcAlias)
+			commonMeta := olmgenerate.CommonMetadata{
+				Links:    olmgenerate.CommonLinks,
+				Keywords: olmgenerate.CommonKeywords,
+			}
+			return olmgenerate.BundleAssets(
+				g, commonMeta, olmgenerate.DefaultServiceConfig(), "0.0.0",
+				templateBasePaths,
+			)
+		},
+	},
+	{
+		"crossplane",
+		func(t *testing.T, svcAlias string) (*templateset.TemplateSet, error) {
+			g := testutil.NewCrossplaneGeneratorForService(t, svcAlias)
+			return cpgenerate.Crossplane(g, templateBasePaths)
+		},
+	},
+}
+
+// TestGoldenFiles generates every target for every service in the testdata
+// and compares the generated files against the golden files in
+// testdata/golden/<service>/<target>. Run `go test ./pkg/testutil -update` to
+// refresh the golden files after changing templates or code emitters.
+//
+// A target that fails to generate for a service is compared by its error
+// message, in an "error" golden file, so that fixing or breaking generation
+// shows up in the diff too.
+func TestGoldenFiles(t *testing.T) {
+	require := require.New(t)
+
+	modelDirs, err := ioutil.ReadDir(
+		filepath.Join(testutil.TestdataPath(), "models", "apis"),
+	)
+	require.Nil(err)
+
+	for _, modelDir := range modelDirs {
+		svcAlias := modelDir.Name()
+		for _, target := range goldenTargets {
+			target := target
+			t.Run(svcAlias+"/"+target.name, func(t *testing.T) {
+				files, err := executeGoldenTarget(t, svcAlias, target)
+				if err != nil {
+					files = map[string]*bytes.Buffer{
+						"error": bytes.NewBufferString(err.Error() + "\n"),
+					}
+				}
+				goldenDir := filepath.Join("testdata", "golden", svcAlias, target.name)
+				testutil.AssertGoldenFiles(t, goldenDir, files)
+			})
+		}
+	}
+}
+
+// executeGoldenTarget returns the files generated for the supplied target and
+// service, by path
+func executeGoldenTarget(
+	t *testing.T,
+	svcAlias string,
+	target goldenTarget,
+) (map[string]*bytes.Buffer, error) {
+	ts, err := target.templateSet(t, svcAlias)
+	if err != nil {
+		return nil, err
+	}
+	if err = ts.Execute(); err != nil {
+		return nil, err
+	}
+	files := ts.Executed()
+	for path, contents := range files {
+		normalized := createdAtRegexp.ReplaceAll(contents.Bytes(), []byte("createdAt: CREATED_AT"))
+		files[path] = bytes.NewBuffer(normalized)
+	}
+	return files, nil
+}
@@ -21,24 +21,38 @@ import (
 
 	"github.com/aws-controllers-k8s/code-generator/pkg/generate"
 	ackgenerate "github.com/aws-controllers-k8s/code-generator/pkg/generate/ack"
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/generate/config"
+	cpgenerate "github.com/aws-controllers-k8s/code-generator/pkg/generate/crossplane"
 	"github.com/aws-controllers-k8s/code-generator/pkg/model"
 )
 
+// crossplaneAPIGroupSuffix is the suffix of the API groups of Crossplane
+// provider resources
+const crossplaneAPIGroupSuffix = "aws.crossplane.io"
+
 func NewGeneratorForService(t *testing.T, serviceAlias string) *generate.Generator {
-	path, _ := filepath.Abs("testdata")
-	// We have subdirectories in pkg/generate that rely on the testdata in
-	// pkg/generate. This code simply detects if we're running from one of
-	// those subdirectories and if so, rebuilds the path to the API model files
-	// in pkg/generate/testdata
-	pathParts := strings.Split(path, "/")
-	for x, pathPart := range pathParts {
-		if pathPart == "generate" {
-			path = filepath.Join(pathParts[0 : x+1]...)
-			path = filepath.Join("/", path, "testdata")
-			break
-		}
-	}
+	return newGeneratorForService(t, serviceAlias, "", ackgenerate.DefaultConfig)
+}
+
+// NewCrossplaneGeneratorForService returns a generator for the supplied
+// service configured the way `ack-generate crossplane` configures it
+func NewCrossplaneGeneratorForService(t *testing.T, serviceAlias string) *generate.Generator {
+	return newGeneratorForService(
+		t, serviceAlias, crossplaneAPIGroupSuffix, cpgenerate.DefaultConfig,
+	)
+}
+
+func newGeneratorForService(
+	t *testing.T,
+	serviceAlias string,
+	apiGroupSuffix string,
+	defaultConfig ackgenconfig.Config,
+) *generate.Generator {
+	path := TestdataPath()
 	sdkHelper := model.NewSDKHelper(path)
+	if apiGroupSuffix != "" {
+		sdkHelper.APIGroupSuffix = apiGroupSuffix
+	}
 	sdkAPI, err := sdkHelper.API(serviceAlias)
 	if err != nil {
 		t.Fatal(err)
@@ -47,9 +61,28 @@ func NewGeneratorForService(t *testing.T, serviceAlias string) *generate.Generat
 	if _, err := os.Stat(generatorConfigPath); os.IsNotExist(err) {
 		generatorConfigPath = ""
 	}
-	g, err := generate.New(sdkAPI, "v1alpha1", generatorConfigPath, ackgenerate.DefaultConfig)
+	g, err := generate.New(sdkAPI, "v1alpha1", generatorConfigPath, defaultConfig)
 	if err != nil {
 		t.Fatal(err)
 	}
 	return g
 }
+
+// TestdataPath returns the path to the directory containing the API model
+// files used by tests, i.e. pkg/generate/testdata
+func TestdataPath() string {
+	path, _ := filepath.Abs("testdata")
+	// We have subdirectories in pkg/generate, and this package, that rely on
+	// the testdata in pkg/generate. This code simply detects if we're running
+	// from one of those directories and if so, rebuilds the path to the API
+	// model files in pkg/generate/testdata
+	pathParts := strings.Split(path, "/")
+	for x, pathPart := range pathParts {
+		if pathPart == "generate" || pathPart == "testutil" {
+			path = filepath.Join(pathParts[0:x]...)
+			path = filepath.Join("/", path, "generate", "testdata")
+			break
+		}
+	}
+	return path
+}
@@ -0,0 +1,66 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package testutil
+
+import (
+	"testing"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/generate"
+	ackgenerate "github.com/aws-controllers-k8s/code-generator/pkg/generate/ack"
+)
+
+// VerifyController generates the API types and the controller for the
+// supplied generator and returns the type errors in the generated code, as
+// found by `ackgenerate.Verify`. The test fails if the code can't be
+// generated or type-checked.
+func VerifyController(
+	t *testing.T,
+	g *generate.Generator,
+	templateBasePaths []string,
+) []*ackgenerate.VerifyError {
+	t.Helper()
+	apis, err := ackgenerate.APIs(g, templateBasePaths)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = apis.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	controller, err := ackgenerate.Controller(g, templateBasePaths)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = controller.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	verrs, err := ackgenerate.Verify(g, apis, controller, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return verrs
+}
+
+// AssertControllerTypeChecks fails the test with every type error in the API
+// types and the controller generated for the supplied generator, naming the
+// template and CRD that produced each error
+func AssertControllerTypeChecks(
+	t *testing.T,
+	g *generate.Generator,
+	templateBasePaths []string,
+) {
+	t.Helper()
+	for _, verr := range VerifyController(t, g, templateBasePaths) {
+		t.Error(verr)
+	}
+}
@@ -165,9 +165,11 @@ echo "Generating deepcopy code for $SERVICE"
 controller-gen object:headerFile=$BOILERPLATE_TXT_PATH paths=./...
 
 echo "Generating custom resource definitions for $SERVICE"
-# Latest version of controller-gen (master) is required for following two reasons
+# controller-gen v0.4.0 or later is required for following two reasons
 # a) support for pointer values in map https://github.com/kubernetes-sigs/controller-tools/pull/317
 # b) support for float type (allowDangerousTypes) https://github.com/kubernetes-sigs/controller-tools/pull/449
+# Services setting `union_validation` in their generator config also need
+# controller-gen v0.9.0 or later for CEL validation rules (XValidation)
 controller-gen crd:allowDangerousTypes=true paths=./... output:crd:artifacts:config=$config_output_dir/crd/bases
 
 popd 1>/dev/null
@@ -10,19 +10,43 @@ import (
 
 {{- end }}
 	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+{{- if .CRD.HasParent }}
+	corev1 "k8s.io/api/core/v1"
+{{- end }}
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 {{ .CRD.Documentation }}
 type {{ .CRD.Kind }}Spec struct {
 	{{- range $fieldName, $field := .CRD.SpecFields }}
-	{{- if $field.ShapeRef }}
-	{{ $field.ShapeRef.Documentation }}
+	{{- if $field.Documentation }}
+	{{ $field.Documentation }}
+	{{- end }}
+	{{- if $field.IsDeprecated }}
+	//
+	// Deprecated: {{ $field.DeprecatedMessage }}
+	// {{ $field.DeprecationMarker }}
+	{{- end }}
+	{{- if $field.IsJSON }}
+	// +kubebuilder:pruning:PreserveUnknownFields
+	{{- end }}
+	{{- range $marker := $field.Markers }}
+	// {{ $marker }}
+	{{- end }}
+	{{- if $field.EnumDef }}
+	// {{ $field.EnumDef.ValidationMarker }}
 	{{- end }}
 	{{ if $field.IsRequired }} // +kubebuilder:validation:Required
 	{{ $field.Names.Camel }} {{ $field.GoType }} `json:"{{ $field.Names.CamelLower }}"`
 	{{- else }} {{ $field.Names.Camel }} {{ $field.GoType }} `json:"{{ $field.Names.CamelLower }},omitempty"` {{ end }}
 {{- end }}
+{{- if .CRD.HasParent }}
+{{- $refNames := .CRD.ParentRefFieldNames }}
+	// {{ $refNames.Camel }} is a reference to the {{ .CRD.Parent.Kind }} custom resource, in
+	// the same namespace, that contains this resource. When set, the
+	// {{ .CRD.ParentField.Names.CamelLower }} field is populated from the referenced resource.
+	{{ $refNames.Camel }} *corev1.LocalObjectReference `json:"{{ $refNames.CamelLower }},omitempty"`
+{{- end }}
 }
 
 // {{ .CRD.Kind }}Status defines the observed state of {{ .CRD.Kind }}
@@ -37,8 +61,19 @@ type {{ .CRD.Kind }}Status struct {
 	// resource
 	Conditions []*ackv1alpha1.Condition `json:"conditions"`
 	{{- range $fieldName, $field := .CRD.StatusFields }}
-	{{- if $field.ShapeRef }}
-	{{ $field.ShapeRef.Documentation }}
+	{{- if $field.Documentation }}
+	{{ $field.Documentation }}
+	{{- end }}
+	{{- if $field.IsDeprecated }}
+	//
+	// Deprecated: {{ $field.DeprecatedMessage }}
+	// {{ $field.DeprecationMarker }}
+	{{- end }}
+	{{- if $field.IsJSON }}
+	// +kubebuilder:pruning:PreserveUnknownFields
+	{{- end }}
+	{{- range $marker := $field.Markers }}
+	// {{ $marker }}
 	{{- end }}
 	{{ $field.Names.Camel }} {{ $field.GoType }} `json:"{{ $field.Names.CamelLower }},omitempty"`
 {{- end }}
@@ -53,8 +88,11 @@ type {{ .CRD.Kind }}Status struct {
 {{- if .CRD.PrintAgeColumn }}
 // +kubebuilder:printcolumn:name="Age",type="date",priority=0,JSONPath=".metadata.creationTimestamp"
 {{- end }}
-{{- if .CRD.ShortNames }}
-// +kubebuilder:resource:shortName={{ Join .CRD.ShortNames ";" }}
+{{- if .CRD.ResourceMarker }}
+// {{ .CRD.ResourceMarker }}
+{{- end }}
+{{- range $marker := .CRD.Markers }}
+// {{ $marker }}
 {{- end }}
 type {{ .CRD.Kind }} struct {
 	metav1.TypeMeta   `json:",inline"`
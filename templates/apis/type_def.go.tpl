@@ -1,11 +1,28 @@
 {{- define "type_def" -}}
-{{- if .Shape.Documentation }}
-{{ .Shape.Documentation }}
+{{- if .Documentation }}
+{{ .Documentation }}
+{{- end }}
+{{- if .UnionValidationMarker }}
+// {{ .UnionValidationMarker }}
 {{- end }}
 type {{ .Names.Camel }} struct {
 {{- range $attrName, $attr := .Attrs }}
-	{{- if $attr.Shape.Documentation }}
-	{{ $attr.Shape.Documentation }}
+	{{- if $attr.Documentation }}
+	{{ $attr.Documentation }}
+	{{- end }}
+	{{- if $attr.IsDeprecated }}
+	//
+	// Deprecated: {{ $attr.DeprecatedMessage }}
+	// {{ $attr.DeprecationMarker }}
+	{{- end }}
+	{{- if $attr.IsJSON }}
+	// +kubebuilder:pruning:PreserveUnknownFields
+	{{- end }}
+	{{- range $marker := $attr.Markers }}
+	// {{ $marker }}
+	{{- end }}
+	{{- if $attr.EnumDef }}
+	// {{ $attr.EnumDef.ValidationMarker }}
 	{{- end }}
 	{{ $attr.Names.Camel }} {{ $attr.GoType }} `json:"{{ $attr.Names.CamelLower }},omitempty"`
 {{- end }}
@@ -5,15 +5,11 @@ package {{ .APIVersion }}
 import (
 	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
 	"github.com/aws/aws-sdk-go/aws"
+{{- range $packagePath, $alias := .TypeImports }}
+	{{ if $alias }}{{ $alias }} {{ end }}"{{ $packagePath }}"
+{{- end }}
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
-
-// Hack to avoid import errors during build...
-var (
-	_ = &metav1.Time{}
-	_ = &aws.JSONValue{}
-	_ = ackv1alpha1.AWSAccountID("")
-)
 {{- range $typeDef := .TypeDefs }}
 
 {{ template "type_def" $typeDef }}
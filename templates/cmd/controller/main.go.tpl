@@ -64,6 +64,10 @@ func main() {
 		os.Exit(1)
 	}
 
+{{- if .GeneratorConfig.ResourceContainsParent }}
+	svcresource.SetKubeClient(mgr.GetClient())
+{{- end }}
+
 	stopChan := ctrlrt.SetupSignalHandler()
 
 	setupLog.Info(
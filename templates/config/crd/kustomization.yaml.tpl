@@ -6,3 +6,9 @@ resources:
 {{- range .CRDNames }}
   - bases/{{ $.APIGroup }}_{{ . }}.yaml 
 {{- end }}
+{{- if .MetadataCRDs }}
+patchesStrategicMerge:
+{{- range .MetadataCRDs }}
+  - patches/{{ $.APIGroup }}_{{ ToLower .Plural }}_metadata.yaml
+{{- end }}
+{{- end }}
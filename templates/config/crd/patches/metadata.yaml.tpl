@@ -0,0 +1,18 @@
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: {{ ToLower .CRD.Plural }}.{{ .APIGroup }}
+{{- with .CRD.CRDMetadata }}
+{{- if .Labels }}
+  labels:
+{{- range $key, $value := .Labels }}
+    {{ printf "%q" $key }}: {{ printf "%q" $value }}
+{{- end }}
+{{- end }}
+{{- if .Annotations }}
+  annotations:
+{{- range $key, $value := .Annotations }}
+    {{ printf "%q" $key }}: {{ printf "%q" $value }}
+{{- end }}
+{{- end }}
+{{- end }}
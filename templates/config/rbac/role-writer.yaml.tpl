@@ -1,3 +1,4 @@
+{{- if .NamespacedCRDNames }}
 ---
 apiVersion: rbac.authorization.k8s.io/v1
 kind: Role
@@ -9,7 +10,7 @@ rules:
 - apiGroups:
   - {{ .APIGroup }}
   resources:
-{{- range $crdName := .CRDNames }}
+{{- range $crdName := .NamespacedCRDNames }}
   - {{ $crdName }}
 {{- end }}
   verbs:
@@ -23,10 +24,44 @@ rules:
 - apiGroups:
   - {{ .APIGroup }}
   resources:
-{{- range $crdName := .CRDNames }}
+{{- range $crdName := .NamespacedCRDNames }}
   - {{ $crdName }}
 {{- end }}
   verbs:
   - get
   - patch
   - update
+{{- end }}
+{{- if .ClusterScopedCRDNames }}
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  creationTimestamp: null
+  name: ack-{{ .ServiceIDClean }}-cluster-writer
+rules:
+- apiGroups:
+  - {{ .APIGroup }}
+  resources:
+{{- range $crdName := .ClusterScopedCRDNames }}
+  - {{ $crdName }}
+{{- end }}
+  verbs:
+  - create
+  - delete
+  - get
+  - list
+  - patch
+  - update
+  - watch
+- apiGroups:
+  - {{ .APIGroup }}
+  resources:
+{{- range $crdName := .ClusterScopedCRDNames }}
+  - {{ $crdName }}
+{{- end }}
+  verbs:
+  - get
+  - patch
+  - update
+{{- end }}
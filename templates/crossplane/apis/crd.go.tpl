@@ -22,8 +22,22 @@ type {{ .CRD.Kind }}Parameters struct {
 	// +kubebuilder:validation:Required
 	Region string `json:"region"`
 	{{- range $fieldName, $field := .CRD.SpecFields }}
-	{{- if $field.ShapeRef }}
-	{{ $field.ShapeRef.Documentation }}
+	{{- if $field.Documentation }}
+	{{ $field.Documentation }}
+	{{- end }}
+	{{- if $field.IsDeprecated }}
+	//
+	// Deprecated: {{ $field.DeprecatedMessage }}
+	// {{ $field.DeprecationMarker }}
+	{{- end }}
+	{{- if $field.IsJSON }}
+	// +kubebuilder:pruning:PreserveUnknownFields
+	{{- end }}
+	{{- range $marker := $field.Markers }}
+	// {{ $marker }}
+	{{- end }}
+	{{- if $field.EnumDef }}
+	// {{ $field.EnumDef.ValidationMarker }}
 	{{- end }}
 	{{ if $field.IsRequired }} // +kubebuilder:validation:Required
 	{{ $field.Names.Camel }} {{ $field.GoType }} `json:"{{ $field.Names.CamelLower }}"`
@@ -41,8 +55,19 @@ type {{ .CRD.Kind }}Spec struct {
 // {{ .CRD.Kind }}Observation defines the observed state of {{ .CRD.Kind }}
 type {{ .CRD.Kind }}Observation struct {
 	{{- range $fieldName, $field := .CRD.StatusFields }}
-	{{- if $field.ShapeRef }}
-	{{ $field.ShapeRef.Documentation }}
+	{{- if $field.Documentation }}
+	{{ $field.Documentation }}
+	{{- end }}
+	{{- if $field.IsDeprecated }}
+	//
+	// Deprecated: {{ $field.DeprecatedMessage }}
+	// {{ $field.DeprecationMarker }}
+	{{- end }}
+	{{- if $field.IsJSON }}
+	// +kubebuilder:pruning:PreserveUnknownFields
+	{{- end }}
+	{{- range $marker := $field.Markers }}
+	// {{ $marker }}
 	{{- end }}
 	{{ $field.Names.Camel }} {{ $field.GoType }} `json:"{{ $field.Names.CamelLower }},omitempty"`
 {{- end }}
@@ -62,7 +87,10 @@ type {{ .CRD.Kind }}Status struct {
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
 // +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
 // +kubebuilder:subresource:status
-// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws{{ range .CRD.Categories }}{{ if not (or (eq . "crossplane") (eq . "managed") (eq . "aws")) }},{{ . }}{{ end }}{{ end }}}
+{{- range $marker := .CRD.Markers }}
+// {{ $marker }}
+{{- end }}
 type {{ .CRD.Kind }} struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
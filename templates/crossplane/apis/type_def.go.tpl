@@ -1,8 +1,25 @@
 {{- define "type_def" -}}
+{{- if .UnionValidationMarker }}
+// {{ .UnionValidationMarker }}
+{{ end -}}
 type {{ .Names.Camel }} struct {
 {{- range $attrName, $attr := .Attrs }}
-	{{- if $attr.Shape }}
-	{{ $attr.Shape.Documentation }}
+	{{- if $attr.Documentation }}
+	{{ $attr.Documentation }}
+	{{- end }}
+	{{- if $attr.IsDeprecated }}
+	//
+	// Deprecated: {{ $attr.DeprecatedMessage }}
+	// {{ $attr.DeprecationMarker }}
+	{{- end }}
+	{{- if $attr.IsJSON }}
+	// +kubebuilder:pruning:PreserveUnknownFields
+	{{- end }}
+	{{- range $marker := $attr.Markers }}
+	// {{ $marker }}
+	{{- end }}
+	{{- if $attr.EnumDef }}
+	// {{ $attr.EnumDef.ValidationMarker }}
 	{{- end }}
 	{{ $attr.Names.Camel }} {{ $attr.GoType }} `json:"{{ $attr.Names.CamelLower }},omitempty"`
 {{- end }}
@@ -5,14 +5,12 @@
 package {{ .APIVersion }}
 
 import (
+{{- range $packagePath, $alias := .TypeImports }}
+	{{ if $alias }}{{ $alias }} {{ end }}"{{ $packagePath }}"
+{{- end }}
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-// Hack to avoid import errors during build...
-var (
-	_ = &metav1.Time{}
-)
-
 {{ range $typeDef := .TypeDefs }}
 
 {{ template "type_def" $typeDef }}
@@ -6,9 +6,16 @@ package {{ .CRD.Names.Lower }}
 
 import (
 	"context"
+{{- if .CRD.HasJSONFields }}
+	"encoding/json"
+	"fmt"
+{{- end }}
 
 	"github.com/pkg/errors"
 	"github.com/google/go-cmp/cmp"
+{{- if .CRD.HasJSONFields }}
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+{{- end }}
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	svcsdkapi "github.com/aws/aws-sdk-go/service/{{ .ServiceIDClean }}/{{ .ServiceIDClean }}iface"
@@ -63,7 +70,14 @@ func (e *external) Observe(ctx context.Context, mg cpresource.Managed) (managed.
 		}, nil
 	}
 {{- if .CRD.Ops.ReadOne }}
-	input := Generate{{ .CRD.Ops.ReadOne.InputRef.Shape.ShapeName }}(cr)
+{{- if .CRD.HasJSONFields }}
+	input, err := Generate{{ .CRD.Ops.ReadOne.InputRef.Shape.ShapeName }}(cr{{ if .CRD.IsSingleton }}, meta.GetExternalName(cr){{ end }})
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, "cannot generate input")
+	}
+{{- else }}
+	input := Generate{{ .CRD.Ops.ReadOne.InputRef.Shape.ShapeName }}(cr{{ if .CRD.IsSingleton }}, meta.GetExternalName(cr){{ end }})
+{{- end }}
 	if err := e.preObserve(ctx, cr, input); err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, "pre-observe failed")
 	}
@@ -72,7 +86,14 @@ func (e *external) Observe(ctx context.Context, mg cpresource.Managed) (managed.
 		return managed.ExternalObservation{ResourceExists: false}, awsclient.Wrap(cpresource.Ignore(IsNotFound, err), errDescribe)
 	}
 {{- else if .CRD.Ops.GetAttributes }}
-	input := Generate{{ .CRD.Ops.GetAttributes.InputRef.Shape.ShapeName }}(cr)
+{{- if .CRD.HasJSONFields }}
+	input, err := Generate{{ .CRD.Ops.GetAttributes.InputRef.Shape.ShapeName }}(cr{{ if .CRD.IsSingleton }}, meta.GetExternalName(cr){{ end }})
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, "cannot generate input")
+	}
+{{- else }}
+	input := Generate{{ .CRD.Ops.GetAttributes.InputRef.Shape.ShapeName }}(cr{{ if .CRD.IsSingleton }}, meta.GetExternalName(cr){{ end }})
+{{- end }}
 	if err := e.preObserve(ctx, cr, input); err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, "pre-observe failed")
 	}
@@ -81,7 +102,14 @@ func (e *external) Observe(ctx context.Context, mg cpresource.Managed) (managed.
 		return managed.ExternalObservation{ResourceExists: false}, awsclient.Wrap(cpresource.Ignore(IsNotFound, err), errDescribe)
 	}
 {{- else if .CRD.Ops.ReadMany }}
-	input := Generate{{ .CRD.Ops.ReadMany.InputRef.Shape.ShapeName }}(cr)
+{{- if .CRD.HasJSONFields }}
+	input, err := Generate{{ .CRD.Ops.ReadMany.InputRef.Shape.ShapeName }}(cr{{ if .CRD.IsSingleton }}, meta.GetExternalName(cr){{ end }})
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, "cannot generate input")
+	}
+{{- else }}
+	input := Generate{{ .CRD.Ops.ReadMany.InputRef.Shape.ShapeName }}(cr{{ if .CRD.IsSingleton }}, meta.GetExternalName(cr){{ end }})
+{{- end }}
 	if err := e.preObserve(ctx, cr, input); err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, "pre-observe failed")
 	}
@@ -98,7 +126,15 @@ func (e *external) Observe(ctx context.Context, mg cpresource.Managed) (managed.
 	if err := e.lateInitialize(&cr.Spec.ForProvider, resp); err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, "late-init failed")
 	}
+{{- if .CRD.HasJSONFields }}
+	observed, err := Generate{{ .CRD.Names.Camel }}(resp)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, "cannot generate observation")
+	}
+	observed.Status.AtProvider.DeepCopyInto(&cr.Status.AtProvider)
+{{- else }}
 	Generate{{ .CRD.Names.Camel }}(resp).Status.AtProvider.DeepCopyInto(&cr.Status.AtProvider)
+{{- end }}
 
 	upToDate, err := e.isUpToDate(cr, resp)
 	if err != nil {
@@ -120,7 +156,14 @@ func (e *external) Create(ctx context.Context, mg cpresource.Managed) (managed.E
 		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
 	}
 	cr.Status.SetConditions(xpv1.Creating())
-	input := Generate{{ .CRD.Ops.Create.InputRef.Shape.ShapeName }}(cr)
+{{- if .CRD.HasJSONFields }}
+	input, err := Generate{{ .CRD.Ops.Create.InputRef.Shape.ShapeName }}(cr{{ if .CRD.IsSingleton }}, meta.GetExternalName(cr){{ end }})
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, "cannot generate input")
+	}
+{{- else }}
+	input := Generate{{ .CRD.Ops.Create.InputRef.Shape.ShapeName }}(cr{{ if .CRD.IsSingleton }}, meta.GetExternalName(cr){{ end }})
+{{- end }}
 	if err := e.preCreate(ctx, cr, input); err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, "pre-create failed")
 	}
@@ -128,7 +171,7 @@ func (e *external) Create(ctx context.Context, mg cpresource.Managed) (managed.E
 	if err != nil {
 		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
 	}
-{{ GoCodeSetCreateOutput .CRD "resp" "cr" 1 false }}
+{{ GoCodeSetResource .CRD (EmitterOptions "Op" "Create" "SourceVarName" "resp" "TargetVarName" "cr" "IndentLevel" 1 "ErrorReturn" "return managed.ExternalCreation{}, err") }}
 	return e.postCreate(ctx, cr, resp, managed.ExternalCreation{}, err)
 }
 
@@ -138,7 +181,14 @@ func (e *external) Update(ctx context.Context, mg cpresource.Managed) (managed.E
 	if !ok {
 		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
 	}
-	input := Generate{{ .CRD.Ops.Update.InputRef.Shape.ShapeName }}(cr)
+{{- if .CRD.HasJSONFields }}
+	input, err := Generate{{ .CRD.Ops.Update.InputRef.Shape.ShapeName }}(cr{{ if .CRD.IsSingleton }}, meta.GetExternalName(cr){{ end }})
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, "cannot generate input")
+	}
+{{- else }}
+	input := Generate{{ .CRD.Ops.Update.InputRef.Shape.ShapeName }}(cr{{ if .CRD.IsSingleton }}, meta.GetExternalName(cr){{ end }})
+{{- end }}
 	if err := e.preUpdate(ctx, cr, input); err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, "pre-update failed")
 	}
@@ -156,7 +206,14 @@ func (e *external) Delete(ctx context.Context, mg cpresource.Managed) error {
 	}
 	cr.Status.SetConditions(xpv1.Deleting())
 	{{- if .CRD.Ops.Delete }}
-	input := Generate{{ .CRD.Ops.Delete.InputRef.Shape.ShapeName }}(cr)
+{{- if .CRD.HasJSONFields }}
+	input, err := Generate{{ .CRD.Ops.Delete.InputRef.Shape.ShapeName }}(cr{{ if .CRD.IsSingleton }}, meta.GetExternalName(cr){{ end }})
+	if err != nil {
+		return errors.Wrap(err, "cannot generate input")
+	}
+{{- else }}
+	input := Generate{{ .CRD.Ops.Delete.InputRef.Shape.ShapeName }}(cr{{ if .CRD.IsSingleton }}, meta.GetExternalName(cr){{ end }})
+{{- end }}
 	ignore, err := e.preDelete(ctx, cr, input)
 	if err != nil {
 		return errors.Wrap(err, "pre-delete failed")
@@ -5,19 +5,40 @@
 package {{ .CRD.Names.Lower }}
 
 import (
+{{- if .CRD.HasJSONFields }}
+	"encoding/json"
+	"fmt"
+
+{{- if not (index .CRD.TypeImports "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1") }}
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+{{- end }}
+{{- end }}
 {{- if .CRD.TypeImports }}
 {{- range $packagePath, $alias := .CRD.TypeImports }}
 	{{ if $alias }}{{ $alias }} {{ end }}"{{ $packagePath }}"
 {{ end }}
 {{- end }}
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	svcsdk "github.com/aws/aws-sdk-go/service/{{ .ServiceIDClean }}"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	svcapitypes "github.com/crossplane/provider-aws/apis/{{ .ServiceIDClean }}/{{ .APIVersion}}"
 )
 
 // NOTE(muvaf): We return pointers in case the function needs to start with an
 // empty object, hence need to return a new pointer.
+{{- if .CRD.HasJSONFields }}
+//
+// The functions also return an error since converting the resource's JSON
+// documents to and from their SDK shapes can fail.
+{{- end }}
+{{- if .CRD.IsSingleton }}
+//
+// There is one {{ .CRD.Names.Camel }} per AWS account, so the functions
+// returning inputs take the ID of the AWS account that it belongs to, which is
+// the external name of the managed resource.
+{{- end }}
 
 {{ if .CRD.Ops.ReadOne }}
     {{- template "sdk_find_read_one" . }}
@@ -28,26 +49,26 @@ import (
 {{- end }}
 
 // Generate{{ .CRD.Ops.Create.InputRef.Shape.ShapeName }} returns a create input.
-func Generate{{ .CRD.Ops.Create.InputRef.Shape.ShapeName }}(cr *svcapitypes.{{ .CRD.Names.Camel }}) *svcsdk.{{ .CRD.Ops.Create.InputRef.Shape.ShapeName }} {
+func Generate{{ .CRD.Ops.Create.InputRef.Shape.ShapeName }}(cr *svcapitypes.{{ .CRD.Names.Camel }}{{ if .CRD.IsSingleton }}, accountID string{{ end }}) {{ if .CRD.HasJSONFields }}(*svcsdk.{{ .CRD.Ops.Create.InputRef.Shape.ShapeName }}, error){{ else }}*svcsdk.{{ .CRD.Ops.Create.InputRef.Shape.ShapeName }}{{ end }} {
 	res := &svcsdk.{{ .CRD.Ops.Create.InputRef.Shape.ShapeName }}{}
-{{ GoCodeSetCreateInput .CRD "cr" "res" 1 }}
-	return res
+{{ GoCodeSetSDK .CRD (EmitterOptions "Op" "Create" "SourceVarName" "cr" "TargetVarName" "res" "IndentLevel" 1 "AccountID" "accountID") }}
+	return res{{ if .CRD.HasJSONFields }}, nil{{ end }}
 }
 {{ if .CRD.Ops.Update -}}
 // Generate{{ .CRD.Ops.Update.InputRef.Shape.ShapeName }} returns an update input.
-func Generate{{ .CRD.Ops.Update.InputRef.Shape.ShapeName }}(cr *svcapitypes.{{ .CRD.Names.Camel }}) *svcsdk.{{ .CRD.Ops.Update.InputRef.Shape.ShapeName }} {
+func Generate{{ .CRD.Ops.Update.InputRef.Shape.ShapeName }}(cr *svcapitypes.{{ .CRD.Names.Camel }}{{ if .CRD.IsSingleton }}, accountID string{{ end }}) {{ if .CRD.HasJSONFields }}(*svcsdk.{{ .CRD.Ops.Update.InputRef.Shape.ShapeName }}, error){{ else }}*svcsdk.{{ .CRD.Ops.Update.InputRef.Shape.ShapeName }}{{ end }} {
 	res := &svcsdk.{{ .CRD.Ops.Update.InputRef.Shape.ShapeName }}{}
-{{ GoCodeSetUpdateInput .CRD "cr" "res" 1 }}
-	return res
+{{ GoCodeSetSDK .CRD (EmitterOptions "Op" "Update" "SourceVarName" "cr" "TargetVarName" "res" "IndentLevel" 1 "AccountID" "accountID") }}
+	return res{{ if .CRD.HasJSONFields }}, nil{{ end }}
 }
 {{- end}}
 
 {{ if .CRD.Ops.Delete -}}
 // Generate{{ .CRD.Ops.Delete.InputRef.Shape.ShapeName }} returns a deletion input.
-func Generate{{ .CRD.Ops.Delete.InputRef.Shape.ShapeName }}(cr *svcapitypes.{{ .CRD.Names.Camel }}) *svcsdk.{{ .CRD.Ops.Delete.InputRef.Shape.ShapeName }} {
+func Generate{{ .CRD.Ops.Delete.InputRef.Shape.ShapeName }}(cr *svcapitypes.{{ .CRD.Names.Camel }}{{ if .CRD.IsSingleton }}, accountID string{{ end }}) {{ if .CRD.HasJSONFields }}(*svcsdk.{{ .CRD.Ops.Delete.InputRef.Shape.ShapeName }}, error){{ else }}*svcsdk.{{ .CRD.Ops.Delete.InputRef.Shape.ShapeName }}{{ end }} {
 	res := &svcsdk.{{ .CRD.Ops.Delete.InputRef.Shape.ShapeName }}{}
-{{ GoCodeSetDeleteInput .CRD "cr" "res" 1 }}
-	return res
+{{ GoCodeSetSDK .CRD (EmitterOptions "Op" "Delete" "SourceVarName" "cr" "TargetVarName" "res" "IndentLevel" 1 "AccountID" "accountID") }}
+	return res{{ if .CRD.HasJSONFields }}, nil{{ end }}
 }
 {{ end }}
 // IsNotFound returns whether the given error is of type NotFound or not.
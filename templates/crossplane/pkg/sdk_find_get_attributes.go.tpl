@@ -1,16 +1,16 @@
 {{- define "sdk_find_get_attributes" -}}
 // Generate{{ .CRD.Ops.GetAttributes.InputRef.Shape.ShapeName }} returns input for read
 // operation.
-func Generate{{ .CRD.Ops.GetAttributes.InputRef.Shape.ShapeName }}(cr *svcapitypes.{{ .CRD.Names.Camel }}) *svcsdk.{{ .CRD.Ops.GetAttributes.InputRef.Shape.ShapeName }} {
+func Generate{{ .CRD.Ops.GetAttributes.InputRef.Shape.ShapeName }}(cr *svcapitypes.{{ .CRD.Names.Camel }}{{ if .CRD.IsSingleton }}, accountID string{{ end }}) {{ if .CRD.HasJSONFields }}(*svcsdk.{{ .CRD.Ops.GetAttributes.InputRef.Shape.ShapeName }}, error){{ else }}*svcsdk.{{ .CRD.Ops.GetAttributes.InputRef.Shape.ShapeName }}{{ end }} {
 	res := &svcsdk.{{ .CRD.Ops.GetAttributes.InputRef.Shape.ShapeName }}{}
 {{ GoCodeGetAttributesSetInput .CRD "cr" "res" 1 }}
-	return res
+	return res{{ if .CRD.HasJSONFields }}, nil{{ end }}
 }
 
 // Generate{{ .CRD.Names.Camel }} returns the current state in the form of *svcapitypes.{{ .CRD.Names.Camel }}.
-func Generate{{ .CRD.Names.Camel }}(resp *svcsdk.{{ .CRD.Ops.GetAttributes.OutputRef.Shape.ShapeName }}) *svcapitypes.{{ .CRD.Names.Camel }} {
+func Generate{{ .CRD.Names.Camel }}(resp *svcsdk.{{ .CRD.Ops.GetAttributes.OutputRef.Shape.ShapeName }}) {{ if .CRD.HasJSONFields }}(*svcapitypes.{{ .CRD.Names.Camel }}, error){{ else }}*svcapitypes.{{ .CRD.Names.Camel }}{{ end }} {
 	cr := &svcapitypes.{{ .CRD.Names.Camel }}{}
 {{ GoCodeGetAttributesSetOutput .CRD "resp" "cr" 1 }}
-return cr
+return cr{{ if .CRD.HasJSONFields }}, nil{{ end }}
 }
 {{- end -}}
\ No newline at end of file
@@ -1,16 +1,16 @@
 {{- define "sdk_find_read_many" -}}
 // Generate{{ .CRD.Ops.ReadMany.InputRef.Shape.ShapeName }} returns input for read
 // operation.
-func Generate{{ .CRD.Ops.ReadMany.InputRef.Shape.ShapeName }}(cr *svcapitypes.{{ .CRD.Names.Camel }}) *svcsdk.{{ .CRD.Ops.ReadMany.InputRef.Shape.ShapeName }} {
+func Generate{{ .CRD.Ops.ReadMany.InputRef.Shape.ShapeName }}(cr *svcapitypes.{{ .CRD.Names.Camel }}{{ if .CRD.IsSingleton }}, accountID string{{ end }}) {{ if .CRD.HasJSONFields }}(*svcsdk.{{ .CRD.Ops.ReadMany.InputRef.Shape.ShapeName }}, error){{ else }}*svcsdk.{{ .CRD.Ops.ReadMany.InputRef.Shape.ShapeName }}{{ end }} {
 	res := &svcsdk.{{ .CRD.Ops.ReadMany.InputRef.Shape.ShapeName }}{}
-{{ GoCodeSetReadManyInput .CRD "cr" "res" 1 }}
-	return res
+{{ GoCodeSetSDK .CRD (EmitterOptions "Op" "ReadMany" "SourceVarName" "cr" "TargetVarName" "res" "IndentLevel" 1 "AccountID" "accountID") }}
+	return res{{ if .CRD.HasJSONFields }}, nil{{ end }}
 }
 
 // Generate{{ .CRD.Names.Camel }} returns the current state in the form of *svcapitypes.{{ .CRD.Names.Camel }}.
-func Generate{{ .CRD.Names.Camel }}(resp *svcsdk.{{ .CRD.Ops.ReadMany.OutputRef.Shape.ShapeName }}) *svcapitypes.{{ .CRD.Names.Camel }} {
+func Generate{{ .CRD.Names.Camel }}(resp *svcsdk.{{ .CRD.Ops.ReadMany.OutputRef.Shape.ShapeName }}) {{ if .CRD.HasJSONFields }}(*svcapitypes.{{ .CRD.Names.Camel }}, error){{ else }}*svcapitypes.{{ .CRD.Names.Camel }}{{ end }} {
 	cr := &svcapitypes.{{ .CRD.Names.Camel }}{}
 {{ GoCodeSetReadManyOutput .CRD "resp" "cr" 1 false }}
-return cr
+return cr{{ if .CRD.HasJSONFields }}, nil{{ end }}
 }
 {{- end -}}
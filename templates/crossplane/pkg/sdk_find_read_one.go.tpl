@@ -1,16 +1,16 @@
 {{- define "sdk_find_read_one" -}}
 // Generate{{ .CRD.Ops.ReadOne.InputRef.Shape.ShapeName }} returns input for read
 // operation.
-func Generate{{ .CRD.Ops.ReadOne.InputRef.Shape.ShapeName }}(cr *svcapitypes.{{ .CRD.Names.Camel }}) *svcsdk.{{ .CRD.Ops.ReadOne.InputRef.Shape.ShapeName }} {
+func Generate{{ .CRD.Ops.ReadOne.InputRef.Shape.ShapeName }}(cr *svcapitypes.{{ .CRD.Names.Camel }}{{ if .CRD.IsSingleton }}, accountID string{{ end }}) {{ if .CRD.HasJSONFields }}(*svcsdk.{{ .CRD.Ops.ReadOne.InputRef.Shape.ShapeName }}, error){{ else }}*svcsdk.{{ .CRD.Ops.ReadOne.InputRef.Shape.ShapeName }}{{ end }} {
 	res := &svcsdk.{{ .CRD.Ops.ReadOne.InputRef.Shape.ShapeName }}{}
-{{ GoCodeSetReadOneInput .CRD "cr" "res" 1 }}
-	return res
+{{ GoCodeSetSDK .CRD (EmitterOptions "Op" "ReadOne" "SourceVarName" "cr" "TargetVarName" "res" "IndentLevel" 1 "AccountID" "accountID") }}
+	return res{{ if .CRD.HasJSONFields }}, nil{{ end }}
 }
 
 // Generate{{ .CRD.Names.Camel }} returns the current state in the form of *svcapitypes.{{ .CRD.Names.Camel }}.
-func Generate{{ .CRD.Names.Camel }}(resp *svcsdk.{{ .CRD.Ops.ReadOne.OutputRef.Shape.ShapeName }}) *svcapitypes.{{ .CRD.Names.Camel }} {
+func Generate{{ .CRD.Names.Camel }}(resp *svcsdk.{{ .CRD.Ops.ReadOne.OutputRef.Shape.ShapeName }}) {{ if .CRD.HasJSONFields }}(*svcapitypes.{{ .CRD.Names.Camel }}, error){{ else }}*svcapitypes.{{ .CRD.Names.Camel }}{{ end }} {
 	cr := &svcapitypes.{{ .CRD.Names.Camel }}{}
 {{ GoCodeSetReadOneOutput .CRD "resp" "cr" 1 false }}
-return cr
+return cr{{ if .CRD.HasJSONFields }}, nil{{ end }}
 }
 {{- end -}}
@@ -0,0 +1,872 @@
+{{ template "boilerplate" }}
+
+// Package fake contains an in-memory fake of the {{ .ServiceID }} API that
+// implements the operations the controller calls for its resources and the
+// operations tagging resources. Tests exercise the controller's resource
+// managers against the fake instead of the real AWS service API.
+package fake
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	svcsdk "github.com/aws/aws-sdk-go/service/{{ .ServiceIDClean }}"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/{{ .ServiceIDClean }}/{{ .ServiceIDClean }}iface"
+)
+
+const (
+	// DefaultAccountID is the AWS account ID used in the ARNs and URLs that
+	// the fake generates
+	DefaultAccountID = "123456789012"
+	// DefaultRegion is the AWS region used in the ARNs and URLs that the fake
+	// generates
+	DefaultRegion = "us-west-2"
+	// NotImplementedCode is the code of the exception returned by the
+	// operations the fake doesn't implement
+	NotImplementedCode = "NotImplemented"
+	// serviceID is the service part of the ARNs and URLs that the fake
+	// generates
+	serviceID = "{{ .ServiceIDClean }}"
+)
+
+var (
+	stringPtrType = reflect.TypeOf((*string)(nil))
+	timePtrType   = reflect.TypeOf((*time.Time)(nil))
+	// generatedSuffixes are the suffixes of the names of the Output shape
+	// string members that the fake generates a value for on Create
+	generatedSuffixes = []string{"Arn", "ARN", "Id", "ID", "Url", "URL"}
+	// identifierSuffixes are the suffixes of the names of the Input shape
+	// string members that the fake matches records on
+	identifierSuffixes = []string{
+		"Arn", "ARN", "Id", "ID", "Url", "URL", "Name", "Identifier",
+	}
+)
+
+// API is an in-memory fake of the {{ .ServiceID }} API. Records of the
+// resources the controller manages are stored in memory, keyed by the
+// resources' identifiers:
+//
+// * Create stores a record built from the Input shape and returns it,
+//   along with generated ARNs, IDs and URLs, in the Output shape
+// * ReadOne, ReadMany and GetAttributes return the matching records or the
+//   API model's NotFound exception
+// * Update and SetAttributes merge the Input shape into the matching record
+// * Delete removes the matching record
+// * The operations of a resource's actions set the lifecycle status of the
+//   matching record to the runtime state they transition the record into
+{{- if .TagOps }}
+// * The operations tagging resources store, remove and return the tags of
+//   the resource identified by the Input shape. The tags a resource is
+//   created with are stored under the resource's generated identifiers.
+{{- end }}
+//
+// Other operations return a NotImplemented exception without touching the
+// records.
+type API struct {
+	// {{ .SDKAPIInterfaceTypeName }}API fails the requests of the operations the fake doesn't
+	// implement
+	svcsdkapi.{{ .SDKAPIInterfaceTypeName }}API
+
+	// AccountID is the AWS account ID used in generated ARNs and URLs
+	AccountID string
+	// Region is the AWS region used in generated ARNs and URLs
+	Region string
+
+	mu sync.Mutex
+	// records contains the records of each kind of resource, by identifier
+	records map[string]map[string]record
+	// tags contains the tags of each resource, by the resource identifier
+	// supplied to the operations tagging resources, e.g. the resource's ARN
+	tags map[string]map[string]string
+	// errors contains the errors injected into operations, by operation name
+	errors map[string]error
+	// latencies contains the latencies injected into operations, by
+	// operation name
+	latencies map[string]time.Duration
+	// lastID is the sequence number of the last generated identifier
+	lastID int
+}
+
+// New returns a new fake of the {{ .ServiceID }} API containing no records
+func New() *API {
+	return &API{
+		{{ .SDKAPIInterfaceTypeName }}API: notImplemented(),
+		AccountID: DefaultAccountID,
+		Region:    DefaultRegion,
+		records:   map[string]map[string]record{},
+		tags:      map[string]map[string]string{},
+		errors:    map[string]error{},
+		latencies: map[string]time.Duration{},
+	}
+}
+
+// notImplemented returns a client of the {{ .ServiceID }} API failing every
+// request with a NotImplemented exception before the request is built, so
+// that no request is ever sent to the real AWS service API. Waiters ignore
+// the exception and fail without delay once they run out of attempts.
+func notImplemented() svcsdkapi.{{ .SDKAPIInterfaceTypeName }}API {
+	sess := session.Must(session.NewSessionWithOptions(session.Options{
+		Config: aws.Config{
+			Region:      aws.String(DefaultRegion),
+			Credentials: credentials.AnonymousCredentials,
+			SleepDelay:  func(time.Duration) {},
+		},
+		SharedConfigState: session.SharedConfigDisable,
+	}))
+	client := svcsdk.New(sess)
+	client.Handlers.Clear()
+	client.Handlers.Validate.PushBack(func(r *request.Request) {
+		r.Error = awserr.New(
+			NotImplementedCode,
+			fmt.Sprintf("the fake %s API does not implement %s", serviceID, r.Operation.Name),
+			nil,
+		)
+	})
+	return client
+}
+
+// SetError makes every call to the supplied operation, e.g. "CreateTopic",
+// return the supplied error until SetError is called again with a nil error
+func (a *API) SetError(opName string, err error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if err == nil {
+		delete(a.errors, opName)
+		return
+	}
+	a.errors[opName] = err
+}
+
+// SetLatency makes every call to the supplied operation, e.g.
+// "CreateTopic", take at least the supplied duration, unless the call's
+// context is done first
+func (a *API) SetLatency(opName string, latency time.Duration) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if latency <= 0 {
+		delete(a.latencies, opName)
+		return
+	}
+	a.latencies[opName] = latency
+}
+
+// Len returns the number of records of the supplied kind of resource, e.g.
+// "Topic"
+func (a *API) Len(kindName string) int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return len(a.records[kindName])
+}
+
+// record contains the members of the Input shapes a resource was created and
+// updated with, and the members the fake generated for it, by member name
+type record map[string]reflect.Value
+
+// kind describes how the records of a kind of resource are identified and
+// the exceptions returned for them
+type kind struct {
+	// name is the name of the resource, e.g. "Topic"
+	name string
+	// keyMembers are the names of the members identifying a record
+	keyMembers []string
+	// inputWrapper is the name of the Input shape member wrapping the
+	// resource's members, if any
+	inputWrapper string
+	// aliases contains the names of the Input shape members that the
+	// resource renames, by the name of the resource's field, e.g. "Bucket"
+	// for "Name"
+	aliases map[string]string
+	// readOnlyAttributes are the names of the attributes in the Attributes
+	// map member of a record that the service API sets
+	readOnlyAttributes []string
+	// readyMember is the name of the member holding the resource's lifecycle
+	// status, if any
+	readyMember string
+	// readyValue is the lifecycle status of created records
+	readyValue string
+	// notFoundCode is the code of the exception returned when no record
+	// matches
+	notFoundCode string
+	// notFoundMessage is the message of the exception returned when no
+	// record matches
+	notFoundMessage string
+	// alreadyExistsCode is the code of the exception returned when a record
+	// is created twice
+	alreadyExistsCode string
+}
+
+// id returns the identifier of the supplied record
+func (k *kind) id(rec record) string {
+	parts := []string{}
+	for _, memberName := range k.keyMembers {
+		part, _ := stringValue(rec[memberName])
+		parts = append(parts, part)
+	}
+	return strings.Join(parts, "/")
+}
+
+// identifies returns true if the supplied member identifies a record
+func (k *kind) identifies(memberName string) bool {
+	for _, keyMember := range k.keyMembers {
+		if memberName == keyMember {
+			return true
+		}
+	}
+	return hasSuffix(memberName, identifierSuffixes)
+}
+
+// member returns the member of the supplied record with the supplied name or
+// with a name the resource renames to or from the supplied name
+func (k *kind) member(rec record, memberName string) (reflect.Value, bool) {
+	if value, found := rec[memberName]; found {
+		return value, true
+	}
+	if alias, found := k.aliases[memberName]; found {
+		if value, found := rec[alias]; found {
+			return value, true
+		}
+	}
+	for fieldName, alias := range k.aliases {
+		if alias != memberName {
+			continue
+		}
+		if value, found := rec[fieldName]; found {
+			return value, true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+// notFound returns the exception returned when no record matches
+func (k *kind) notFound() error {
+	return awserr.New(k.notFoundCode, k.notFoundMessage, nil)
+}
+
+// call waits for the latency injected into the supplied operation and
+// returns the error injected into it, if any
+func (a *API) call(ctx aws.Context, opName string) error {
+	a.mu.Lock()
+	latency := a.latencies[opName]
+	err := a.errors[opName]
+	a.mu.Unlock()
+	if latency > 0 {
+		select {
+		case <-time.After(latency):
+		case <-ctx.Done():
+			return awserr.New(
+				request.CanceledErrorCode, "request context canceled", ctx.Err(),
+			)
+		}
+	}
+	return err
+}
+
+// create stores a record built from the supplied Input shape and sets the
+// supplied Output shape from it
+func (a *API) create(
+	k *kind,
+	input interface{},
+	output interface{},
+	wrapperPath []string,
+) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	rec := members(input, k.inputWrapper)
+	target := unwrap(output, wrapperPath)
+	for i := 0; i < target.NumField(); i++ {
+		field := target.Type().Field(i)
+		if _, found := k.member(rec, field.Name); found || field.PkgPath != "" {
+			continue
+		}
+		switch {
+		case field.Type == stringPtrType && hasSuffix(field.Name, generatedSuffixes):
+			rec[field.Name] = reflect.ValueOf(aws.String(a.newIdentifier(k, field.Name)))
+		case field.Type == timePtrType && strings.HasPrefix(field.Name, "Creat"):
+			rec[field.Name] = reflect.ValueOf(aws.Time(time.Now()))
+		}
+	}
+	for _, memberName := range k.keyMembers {
+		if _, found := rec[memberName]; !found {
+			rec[memberName] = reflect.ValueOf(aws.String(a.newIdentifier(k, memberName)))
+		}
+	}
+	if len(k.readOnlyAttributes) > 0 {
+		attrs, found := rec["Attributes"]
+		if !found {
+			attrs = reflect.ValueOf(map[string]*string{})
+			rec["Attributes"] = attrs
+		}
+		for _, attrName := range k.readOnlyAttributes {
+			key := reflect.ValueOf(attrName)
+			if attrs.MapIndex(key).IsValid() {
+				continue
+			}
+			value := a.newIdentifier(k, attrName)
+			if strings.HasSuffix(attrName, "Timestamp") {
+				value = strconv.FormatInt(time.Now().Unix(), 10)
+			}
+			attrs.SetMapIndex(key, reflect.ValueOf(aws.String(value)))
+		}
+	}
+	if _, found := rec[k.readyMember]; k.readyMember != "" && !found {
+		rec[k.readyMember] = reflect.ValueOf(aws.String(k.readyValue))
+	}
+	records, found := a.records[k.name]
+	if !found {
+		records = map[string]record{}
+		a.records[k.name] = records
+	}
+	id := k.id(rec)
+	if _, found := records[id]; found {
+		return awserr.New(
+			k.alreadyExistsCode,
+			fmt.Sprintf("%s %s already exists", k.name, id),
+			nil,
+		)
+	}
+	records[id] = rec
+	// Resources are tagged with the identifiers the service API generates
+	if tags := tagValues(rec); len(tags) > 0 {
+		for memberName, value := range rec {
+			if resourceID, found := stringValue(value); found && k.identifies(memberName) {
+				a.tags[resourceID] = tags
+			}
+		}
+	}
+	fill(k, target, rec)
+	return nil
+}
+
+// readOne sets the supplied Output shape from the record matching the
+// supplied Input shape
+func (a *API) readOne(
+	k *kind,
+	input interface{},
+	output interface{},
+	wrapperPath []string,
+) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	ids := a.match(k, members(input, k.inputWrapper), false)
+	if len(ids) == 0 {
+		return k.notFound()
+	}
+	fill(k, unwrap(output, wrapperPath), a.records[k.name][ids[0]])
+	return nil
+}
+
+// readMany sets the supplied list member of the supplied Output shape from
+// the records matching the supplied Input shape
+func (a *API) readMany(
+	k *kind,
+	input interface{},
+	output interface{},
+	listMemberName string,
+) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	ids := a.match(k, members(input, k.inputWrapper), true)
+	list := reflect.ValueOf(output).Elem().FieldByName(listMemberName)
+	elemType := list.Type().Elem()
+	res := reflect.MakeSlice(list.Type(), 0, len(ids))
+	for _, id := range ids {
+		rec := a.records[k.name][id]
+		switch {
+		case elemType.Kind() == reflect.Ptr && elemType.Elem().Kind() == reflect.Struct:
+			elem := reflect.New(elemType.Elem())
+			fill(k, elem.Elem(), rec)
+			res = reflect.Append(res, elem)
+		case elemType == stringPtrType && len(k.keyMembers) > 0:
+			res = reflect.Append(res, clone(rec[k.keyMembers[0]]))
+		}
+	}
+	list.Set(res)
+	return nil
+}
+
+// update merges the supplied Input shape into the record matching it and
+// sets the supplied Output shape, if any, from the record
+func (a *API) update(
+	k *kind,
+	input interface{},
+	output interface{},
+	wrapperPath []string,
+) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	in := members(input, k.inputWrapper)
+	ids := a.match(k, in, false)
+	if len(ids) == 0 {
+		return k.notFound()
+	}
+	rec := a.records[k.name][ids[0]]
+	// Some SetAttributes operations set a single attribute at a time
+	if attrName, found := stringValue(in["AttributeName"]); found {
+		attrs, found := rec["Attributes"]
+		if !found {
+			attrs = reflect.ValueOf(map[string]*string{})
+			rec["Attributes"] = attrs
+		}
+		attrs.SetMapIndex(reflect.ValueOf(attrName), clone(in["AttributeValue"]))
+		delete(in, "AttributeName")
+		delete(in, "AttributeValue")
+	}
+	for memberName, value := range in {
+		current, found := rec[memberName]
+		if found && current.Kind() == reflect.Map && current.Type() == value.Type() {
+			iter := value.MapRange()
+			for iter.Next() {
+				current.SetMapIndex(iter.Key(), iter.Value())
+			}
+			continue
+		}
+		rec[memberName] = value
+	}
+	if output != nil {
+		fill(k, unwrap(output, wrapperPath), rec)
+	}
+	return nil
+}
+
+// delete removes the record matching the supplied Input shape
+func (a *API) delete(
+	k *kind,
+	input interface{},
+) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	ids := a.match(k, members(input, k.inputWrapper), false)
+	if len(ids) == 0 {
+		return k.notFound()
+	}
+	delete(a.records[k.name], ids[0])
+	return nil
+}
+
+// transition sets the lifecycle status of the record matching the supplied
+// Input shape to the supplied status
+func (a *API) transition(
+	k *kind,
+	input interface{},
+	status string,
+) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	ids := a.match(k, members(input, k.inputWrapper), false)
+	if len(ids) == 0 {
+		return k.notFound()
+	}
+	if k.readyMember != "" {
+		a.records[k.name][ids[0]][k.readyMember] = reflect.ValueOf(aws.String(status))
+	}
+	return nil
+}
+
+// tagResource adds the tags of the supplied Input shape to the tags of the
+// resource it identifies
+func (a *API) tagResource(
+	input interface{},
+) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	in := members(input, "")
+	resourceID := taggedResourceID(in)
+	tags, found := a.tags[resourceID]
+	if !found {
+		tags = map[string]string{}
+		a.tags[resourceID] = tags
+	}
+	for key, value := range tagValues(in) {
+		tags[key] = value
+	}
+}
+
+// untagResource removes the tags with the keys of the supplied Input shape
+// from the tags of the resource it identifies
+func (a *API) untagResource(
+	input interface{},
+) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	in := members(input, "")
+	tags := a.tags[taggedResourceID(in)]
+	for memberName, value := range in {
+		if !hasSuffix(memberName, []string{"TagKeys", "TagKeyList"}) {
+			continue
+		}
+		for i := 0; i < value.Len(); i++ {
+			if key, found := stringValue(value.Index(i)); found {
+				delete(tags, key)
+			}
+		}
+	}
+}
+
+// listTags sets the tags member of the supplied Output shape from the tags of
+// the resource the supplied Input shape identifies
+func (a *API) listTags(
+	input interface{},
+	output interface{},
+) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	tags := a.tags[taggedResourceID(members(input, ""))]
+	target := reflect.ValueOf(output).Elem()
+	for i := 0; i < target.NumField(); i++ {
+		field := target.Type().Field(i)
+		if field.PkgPath != "" || !isTagsMember(field.Name) {
+			continue
+		}
+		if value := newTags(field.Type, tags); value.IsValid() {
+			target.Field(i).Set(value)
+			return
+		}
+	}
+}
+
+// taggedResourceID returns the identifier of the resource that the supplied
+// Input shape members of an operation tagging resources identify, e.g. the
+// value of a "ResourceArn" member
+func taggedResourceID(in record) string {
+	memberNames := []string{}
+	for memberName := range in {
+		memberNames = append(memberNames, memberName)
+	}
+	sort.Strings(memberNames)
+	for _, memberName := range memberNames {
+		if strings.Contains(memberName, "Tag") {
+			continue
+		}
+		if resourceID, found := stringValue(in[memberName]); found {
+			return resourceID
+		}
+	}
+	return ""
+}
+
+// isTagsMember returns true if the member with the supplied name holds tags,
+// e.g. "Tags" or "TagList"
+func isTagsMember(memberName string) bool {
+	return hasSuffix(memberName, []string{"Tags", "TagList"}) &&
+		!hasSuffix(memberName, []string{"TagKeys"})
+}
+
+// tagValues returns the tags held by the supplied shape members, by key.
+// Tags are held either in a map or in a list of structs with Key and Value
+// members.
+func tagValues(in record) map[string]string {
+	res := map[string]string{}
+	for memberName, value := range in {
+		if !isTagsMember(memberName) {
+			continue
+		}
+		switch {
+		case value.Kind() == reflect.Map && value.Type().Key().Kind() == reflect.String:
+			iter := value.MapRange()
+			for iter.Next() {
+				tagValue, _ := stringValue(iter.Value())
+				res[iter.Key().String()] = tagValue
+			}
+		case value.Kind() == reflect.Slice:
+			for i := 0; i < value.Len(); i++ {
+				elem := reflect.Indirect(value.Index(i))
+				if elem.Kind() != reflect.Struct {
+					continue
+				}
+				key, found := stringValue(elem.FieldByName("Key"))
+				if !found {
+					continue
+				}
+				tagValue, _ := stringValue(elem.FieldByName("Value"))
+				res[key] = tagValue
+			}
+		}
+	}
+	return res
+}
+
+// newTags returns a value of the supplied type, either a map or a list of
+// structs with Key and Value members, holding the supplied tags sorted by
+// key. The returned value is invalid if the type can't hold tags.
+func newTags(t reflect.Type, tags map[string]string) reflect.Value {
+	keys := []string{}
+	for key := range tags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	switch {
+	case t.Kind() == reflect.Map && t.Key().Kind() == reflect.String && t.Elem() == stringPtrType:
+		res := reflect.MakeMapWithSize(t, len(keys))
+		for _, key := range keys {
+			res.SetMapIndex(reflect.ValueOf(key), reflect.ValueOf(aws.String(tags[key])))
+		}
+		return res
+	case t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Ptr && t.Elem().Elem().Kind() == reflect.Struct:
+		elemType := t.Elem().Elem()
+		keyField, hasKey := elemType.FieldByName("Key")
+		valueField, hasValue := elemType.FieldByName("Value")
+		if !hasKey || !hasValue || keyField.Type != stringPtrType || valueField.Type != stringPtrType {
+			return reflect.Value{}
+		}
+		res := reflect.MakeSlice(t, 0, len(keys))
+		for _, key := range keys {
+			elem := reflect.New(elemType)
+			elem.Elem().FieldByName("Key").Set(reflect.ValueOf(aws.String(key)))
+			elem.Elem().FieldByName("Value").Set(reflect.ValueOf(aws.String(tags[key])))
+			res = reflect.Append(res, elem)
+		}
+		return res
+	}
+	return reflect.Value{}
+}
+
+// match returns the sorted identifiers of the records matching the supplied
+// Input shape members. A record matches if each identifying member of the
+// Input shape, or each element of a list of identifying members, e.g.
+// "TopicArns", is equal to the record's member. Unless many records are
+// looked up, at least one identifying member must be supplied.
+func (a *API) match(
+	k *kind,
+	in record,
+	many bool,
+) []string {
+	ids := []string{}
+	for id := range a.records[k.name] {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	res := []string{}
+	for _, id := range ids {
+		rec := a.records[k.name][id]
+		matched, constrained := true, false
+		for memberName, value := range in {
+			if value.Kind() == reflect.Slice {
+				if value.Len() == 0 {
+					continue
+				}
+				memberName = strings.TrimSuffix(memberName, "List")
+				memberName = strings.TrimSuffix(memberName, "s")
+			}
+			if !k.identifies(memberName) {
+				continue
+			}
+			recValue, _ := k.member(rec, memberName)
+			want, found := stringValue(recValue)
+			if !found {
+				continue
+			}
+			constrained = true
+			if !containsString(value, want) {
+				matched = false
+			}
+		}
+		if !matched || (!constrained && !many && len(k.keyMembers) > 0) {
+			continue
+		}
+		res = append(res, id)
+	}
+	return res
+}
+
+// newIdentifier returns a new value for the supplied string member of a
+// record, e.g. an ARN for a member named "TopicArn"
+func (a *API) newIdentifier(k *kind, memberName string) string {
+	a.lastID++
+	id := fmt.Sprintf("%08x", a.lastID)
+	switch {
+	case hasSuffix(memberName, []string{"Arn", "ARN"}):
+		return fmt.Sprintf(
+			"arn:aws:%s:%s:%s:%s/%s",
+			serviceID, a.Region, a.AccountID, strings.ToLower(k.name), id,
+		)
+	case hasSuffix(memberName, []string{"Url", "URL"}):
+		return fmt.Sprintf(
+			"https://%s.%s.amazonaws.com/%s/%s",
+			serviceID, a.Region, a.AccountID, id,
+		)
+	case hasSuffix(memberName, []string{"Id", "ID"}) && len(memberName) > 2:
+		return strings.ToLower(memberName[:len(memberName)-2]) + "-" + id
+	}
+	return strings.ToLower(k.name) + "-" + id
+}
+
+// members returns the non-nil exported members of the supplied shape. The
+// members of the wrapper member with the supplied name, if any, are returned
+// in place of the wrapper member.
+func members(shape interface{}, wrapper string) record {
+	rec := record{}
+	v := reflect.ValueOf(shape).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		value := v.Field(i)
+		if field.PkgPath != "" || isNil(value) {
+			continue
+		}
+		if field.Name == wrapper && value.Kind() == reflect.Ptr {
+			for memberName, member := range members(value.Interface(), "") {
+				rec[memberName] = member
+			}
+			continue
+		}
+		rec[field.Name] = clone(value)
+	}
+	return rec
+}
+
+// unwrap returns the struct of the supplied Output shape holding the
+// resource's members, allocating the wrapper members along the supplied path
+func unwrap(output interface{}, wrapperPath []string) reflect.Value {
+	v := reflect.ValueOf(output).Elem()
+	for _, wrapperName := range wrapperPath {
+		wrapper := v.FieldByName(wrapperName)
+		if wrapper.IsNil() {
+			wrapper.Set(reflect.New(wrapper.Type().Elem()))
+		}
+		v = wrapper.Elem()
+	}
+	return v
+}
+
+// fill sets each exported field of the supplied struct that the supplied
+// record has a member of the same name and type for
+func fill(k *kind, target reflect.Value, rec record) {
+	for i := 0; i < target.NumField(); i++ {
+		field := target.Type().Field(i)
+		value, found := k.member(rec, field.Name)
+		if field.PkgPath != "" || !found || !value.Type().AssignableTo(field.Type) {
+			continue
+		}
+		target.Field(i).Set(clone(value))
+	}
+}
+
+// clone returns a deep copy of the supplied value, so that records never
+// share memory with the shapes they are built from or returned in
+func clone(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return v
+		}
+		res := reflect.New(v.Type().Elem())
+		res.Elem().Set(clone(v.Elem()))
+		return res
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		res := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			res.Index(i).Set(clone(v.Index(i)))
+		}
+		return res
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		res := reflect.MakeMapWithSize(v.Type(), v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			res.SetMapIndex(iter.Key(), clone(iter.Value()))
+		}
+		return res
+	case reflect.Struct:
+		// Structs with unexported fields, e.g. time.Time, are copied as is
+		res := reflect.New(v.Type()).Elem()
+		res.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).PkgPath == "" {
+				res.Field(i).Set(clone(v.Field(i)))
+			}
+		}
+		return res
+	}
+	return v
+}
+
+// isNil returns true if the supplied value is a nil pointer, slice, map or
+// interface
+func isNil(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Map, reflect.Interface:
+		return v.IsNil()
+	}
+	return false
+}
+
+// stringValue returns the string the supplied value points to, if it is a
+// non-nil string pointer
+func stringValue(v reflect.Value) (string, bool) {
+	if !v.IsValid() || v.Type() != stringPtrType || v.IsNil() {
+		return "", false
+	}
+	return v.Elem().String(), true
+}
+
+// containsString returns true if the supplied string pointer, or any element
+// of the supplied slice of string pointers, points to the supplied string
+func containsString(v reflect.Value, s string) bool {
+	if v.Kind() != reflect.Slice {
+		got, found := stringValue(v)
+		return found && got == s
+	}
+	for i := 0; i < v.Len(); i++ {
+		if got, found := stringValue(v.Index(i)); found && got == s {
+			return true
+		}
+	}
+	return false
+}
+
+// hasSuffix returns true if the supplied string has any of the supplied
+// suffixes
+func hasSuffix(s string, suffixes []string) bool {
+	for _, suffix := range suffixes {
+		if strings.HasSuffix(s, suffix) {
+			return true
+		}
+	}
+	return false
+}
+{{- range $fakeOp := .TagOps }}
+{{- $op := $fakeOp.Op }}
+
+// {{ $op.ExportedName }}WithContext implements the {{ $fakeOp.Type }} operation
+// of resources against the fake's tags
+func (a *API) {{ $op.ExportedName }}WithContext(
+	ctx aws.Context,
+	input *svcsdk.{{ $op.InputRef.Shape.ShapeName }},
+	opts ...request.Option,
+) (*svcsdk.{{ $op.OutputRef.Shape.ShapeName }}, error) {
+	if err := a.call(ctx, "{{ $op.ExportedName }}"); err != nil {
+		return nil, err
+	}
+	output := &svcsdk.{{ $op.OutputRef.Shape.ShapeName }}{}
+{{- if eq $fakeOp.Type "Tag" }}
+	a.tagResource(input)
+{{- else if eq $fakeOp.Type "Untag" }}
+	a.untagResource(input)
+{{- else }}
+	a.listTags(input, output)
+{{- end }}
+	return output, nil
+}
+
+// {{ $op.ExportedName }} calls {{ $op.ExportedName }}WithContext with a
+// background context
+func (a *API) {{ $op.ExportedName }}(
+	input *svcsdk.{{ $op.InputRef.Shape.ShapeName }},
+) (*svcsdk.{{ $op.OutputRef.Shape.ShapeName }}, error) {
+	return a.{{ $op.ExportedName }}WithContext(aws.BackgroundContext(), input)
+}
+{{- end }}
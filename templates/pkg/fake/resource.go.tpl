@@ -0,0 +1,54 @@
+{{ template "boilerplate" }}
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/{{ .ServiceIDClean }}"
+)
+
+// {{ .CRD.Names.CamelLower }}Kind describes the records of {{ .CRD.Names.Camel }} resources
+var {{ .CRD.Names.CamelLower }}Kind = {{ GoCodeFakeKind .CRD 0 }}
+{{- range $fakeOp := .Ops }}
+{{- $op := $fakeOp.Op }}
+{{- $kind := printf "%sKind" $.CRD.Names.CamelLower }}
+
+// {{ $op.ExportedName }}WithContext implements the {{ $fakeOp.Type }} operation of
+// {{ $.CRD.Names.Camel }} resources against the fake's records
+func (a *API) {{ $op.ExportedName }}WithContext(
+	ctx aws.Context,
+	input *svcsdk.{{ $op.InputRef.Shape.ShapeName }},
+	opts ...request.Option,
+) ({{ $.CRD.GetOutputShapeGoType $op }}, error) {
+	if err := a.call(ctx, "{{ $op.ExportedName }}"); err != nil {
+		return nil, err
+	}
+	output := &svcsdk.{{ $op.OutputRef.Shape.ShapeName }}{}
+{{- if eq $fakeOp.Type "Create" }}
+	err := a.create({{ $kind }}, input, output, {{ GoCodeFakeOutputWrapperPath $.CRD $fakeOp.Type }})
+{{- else if eq $fakeOp.Type "ReadMany" }}
+	err := a.readMany({{ $kind }}, input, output, "{{ ListMemberNameInReadManyOutput $.CRD }}")
+{{- else if or (eq $fakeOp.Type "ReadOne") (eq $fakeOp.Type "GetAttributes") }}
+	err := a.readOne({{ $kind }}, input, output, {{ GoCodeFakeOutputWrapperPath $.CRD $fakeOp.Type }})
+{{- else if or (eq $fakeOp.Type "Update") (eq $fakeOp.Type "SetAttributes") }}
+	err := a.update({{ $kind }}, input, output, {{ GoCodeFakeOutputWrapperPath $.CRD $fakeOp.Type }})
+{{- else if eq $fakeOp.Type "Action" }}
+	err := a.transition({{ $kind }}, input, "{{ $fakeOp.Observed }}")
+{{- else }}
+	err := a.delete({{ $kind }}, input)
+{{- end }}
+	if err != nil {
+		return nil, err
+	}
+	return output, nil
+}
+
+// {{ $op.ExportedName }} calls {{ $op.ExportedName }}WithContext with a
+// background context
+func (a *API) {{ $op.ExportedName }}(
+	input *svcsdk.{{ $op.InputRef.Shape.ShapeName }},
+) ({{ $.CRD.GetOutputShapeGoType $op }}, error) {
+	return a.{{ $op.ExportedName }}WithContext(aws.BackgroundContext(), input)
+}
+{{- end }}
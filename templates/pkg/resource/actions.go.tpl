@@ -0,0 +1,86 @@
+{{ template "boilerplate" }}
+
+package {{ .CRD.Names.Snake }}
+
+import (
+	"context"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	"github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/{{ .ServiceIDClean }}"
+
+	svcapitypes "github.com/aws-controllers-k8s/{{ .ServiceIDClean }}-controller/apis/{{ .APIVersion }}"
+)
+{{- $field := .CRD.ActionField }}
+
+// setObservedDesiredState sets the Spec.{{ $field.Names.Camel }} field of the
+// supplied observed resource from the value of its lifecycle status field, so
+// that a difference between the desired and observed runtime state of the
+// resource shows up in the resource's Delta. The field is left untouched if
+// no runtime state was requested or if the resource is transitioning between
+// runtime states.
+func setObservedDesiredState(
+	ko *svcapitypes.{{ .CRD.Names.Camel }},
+) {
+	if ko.Spec.{{ $field.Names.Camel }} == nil {
+		return
+	}
+	switch lifecycleStatus(ko) {
+{{- range $action := .CRD.Actions }}
+	case {{ range $x, $value := $action.Observed -}}{{ if ne ($x) (0) }}, {{ end }}"{{ $value }}"{{ end }}:
+		ko.Spec.{{ $field.Names.Camel }} = aws.String("{{ $action.Value }}")
+{{- end }}
+	}
+}
+
+// syncDesiredState calls the API operation that transitions the resource into
+// the runtime state requested in the desired resource's
+// Spec.{{ $field.Names.Camel }} field.
+//
+// An error requeueing the resource is returned if the operation was called or
+// if the latest resource is transitioning between runtime states. A nil error
+// is returned if there is nothing to do.
+func (rm *resourceManager) syncDesiredState(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	delta *ackcompare.Delta,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncDesiredState")
+	defer exit(err)
+
+	if desired.ko.Spec.{{ $field.Names.Camel }} == nil ||
+		!delta.DifferentAt("Spec.{{ $field.Names.Camel }}") {
+		return nil
+	}
+	if lifecycleInProgress(latest.ko) {
+		// Wait for the resource to settle before transitioning it
+		return requeue.NeededAfter(
+			ackerr.TemporaryOutOfSync,
+			requeue.DefaultRequeueAfterDuration,
+		)
+	}
+	switch *desired.ko.Spec.{{ $field.Names.Camel }} {
+{{- range $action := .CRD.Actions }}
+	case "{{ $action.Value }}":
+		input := &svcsdk.{{ $action.Op.InputRef.Shape.ShapeName }}{}
+{{- GoCodeSetActionInput $.CRD $action "desired.ko" "input" 2 }}
+		_, err = rm.sdkapi.{{ $action.Op.ExportedName }}WithContext(ctx, input)
+		rm.metrics.RecordAPICall("UPDATE", "{{ $action.Op.ExportedName }}", err)
+{{- end }}
+	default:
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	// The backend AWS service API transitions the resource asynchronously
+	return requeue.NeededAfter(
+		ackerr.TemporaryOutOfSync,
+		requeue.DefaultRequeueAfterDuration,
+	)
+}
@@ -0,0 +1,77 @@
+{{ template "boilerplate" }}
+
+package {{ .CRD.Names.Snake }}
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcapitypes "github.com/aws-controllers-k8s/{{ .ServiceIDClean }}-controller/apis/{{ .APIVersion }}"
+)
+
+// ensureNoChildren returns an error requeueing the deletion of the supplied
+// resource while any child custom resource still refers to it, either by
+// reference, by owner reference or by the resource's identifier.
+//
+// The ACK runtime only removes its finalizer from the resource once Delete
+// succeeds, so neither the {{ .CRD.Kind }} custom resource nor the resource in
+// the backend AWS service API is deleted before its children.
+func (rm *resourceManager) ensureNoChildren(
+	ctx context.Context,
+	r *resource,
+) error {
+	if rm.kc == nil {
+		return fmt.Errorf(
+			"unable to list the children of {{ .CRD.Kind }} %s: no Kubernetes client",
+			r.ko.Name,
+		)
+	}
+	numChildren := 0
+{{- range $child := .CRD.Children }}
+{{- $listVarName := printf "%sList" $child.Names.CamelLower }}
+{{- $refNames := $child.ParentRefFieldNames }}
+{{- $parentField := $child.ParentField }}
+{{- $parentIDPath := $child.ParentIdentifierFieldPath }}
+	{{ $listVarName }} := &svcapitypes.{{ $child.Names.Camel }}List{}
+	if err := rm.kc.List(ctx, {{ $listVarName }}, client.InNamespace(r.ko.Namespace)); err != nil {
+		return err
+	}
+	for i := range {{ $listVarName }}.Items {
+		child := &{{ $listVarName }}.Items[i]
+		if isOwnedBy(child.OwnerReferences, r) ||
+			(child.Spec.{{ $refNames.Camel }} != nil && child.Spec.{{ $refNames.Camel }}.Name == r.ko.Name) ||
+			(child.Spec.{{ $parentField.Names.Camel }} != nil && r.ko.{{ $parentIDPath }} != nil &&
+				*child.Spec.{{ $parentField.Names.Camel }} == *r.ko.{{ $parentIDPath }}) {
+			numChildren++
+		}
+	}
+{{- end }}
+	if numChildren > 0 {
+		return requeue.NeededAfter(
+			fmt.Errorf(
+				"{{ .CRD.Kind }} %s still has %d child resource(s)",
+				r.ko.Name, numChildren,
+			),
+			requeue.DefaultRequeueAfterDuration,
+		)
+	}
+	return nil
+}
+
+// isOwnedBy returns true if the supplied owner references include the
+// supplied resource
+func isOwnedBy(
+	ownerRefs []metav1.OwnerReference,
+	r *resource,
+) bool {
+	for _, ref := range ownerRefs {
+		if ref.UID == r.ko.UID {
+			return true
+		}
+	}
+	return false
+}
@@ -3,9 +3,19 @@
 package {{ .CRD.Names.Snake }}
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net"
+	"reflect"
+	"strconv"
+	"strings"
+
 	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
 )
 
+{{- $usesJSON := or .CRD.HasJSONFields (.CRD.UsesComparator "json") }}
+
 // newResourceDelta returns a new `ackcompare.Delta` used to compare two
 // resources
 func newResourceDelta(
@@ -28,3 +38,190 @@ func newResourceDelta(
 {{- end }}
     return delta
 }
+{{- if $usesJSON }}
+
+// equalJSON returns true if the supplied JSON documents are semantically
+// equal, ignoring differences in key order and whitespace
+func equalJSON(a, b []byte) bool {
+	var aValue, bValue interface{}
+	if err := json.Unmarshal(a, &aValue); err != nil {
+		return bytes.Equal(a, b)
+	}
+	if err := json.Unmarshal(b, &bValue); err != nil {
+		return bytes.Equal(a, b)
+	}
+	return reflect.DeepEqual(aValue, bValue)
+}
+{{- end }}
+{{- if .CRD.HasUnorderedListFields }}
+
+// equalUnordered returns true if the supplied slices contain the same
+// elements, regardless of the order of the elements. Elements are compared
+// with reflect.DeepEqual, so pointers to equal values are equal.
+func equalUnordered(a, b interface{}) bool {
+	aValue := reflect.ValueOf(a)
+	bValue := reflect.ValueOf(b)
+	if aValue.Len() != bValue.Len() {
+		return false
+	}
+	matched := make([]bool, bValue.Len())
+	for i := 0; i < aValue.Len(); i++ {
+		found := false
+		for j := 0; j < bValue.Len(); j++ {
+			if !matched[j] && reflect.DeepEqual(
+				aValue.Index(i).Interface(), bValue.Index(j).Interface(),
+			) {
+				matched[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+{{- end }}
+
+// equalKeys returns true if the supplied pointers to structs are not nil and
+// the structs have equal values in all of the supplied key fields, meaning
+// that they are the same element of a list whose elements are identified by
+// those fields. Custom code reconciling such lists, e.g. tags or collections
+// of child resources, should use it to match elements the same way the
+// resource delta does.
+func equalKeys(a, b interface{}, keyFields ...string) bool {
+	aValue := reflect.ValueOf(a)
+	bValue := reflect.ValueOf(b)
+	if aValue.IsNil() || bValue.IsNil() {
+		return false
+	}
+	aValue = aValue.Elem()
+	bValue = bValue.Elem()
+	for _, keyField := range keyFields {
+		if !reflect.DeepEqual(
+			aValue.FieldByName(keyField).Interface(),
+			bValue.FieldByName(keyField).Interface(),
+		) {
+			return false
+		}
+	}
+	return true
+}
+
+// elementKey returns the values of the supplied key fields of the supplied
+// pointer to a struct joined with commas, e.g. "tcp,80", which identifies the
+// element of a list whose elements are identified by those fields. The
+// resource delta uses it in the paths of the differences in such lists, e.g.
+// "Spec.Rules.tcp,80.Description".
+func elementKey(elem interface{}, keyFields ...string) string {
+	value := reflect.ValueOf(elem)
+	if value.IsNil() {
+		return ""
+	}
+	value = value.Elem()
+	parts := make([]string, 0, len(keyFields))
+	for _, keyField := range keyFields {
+		field := value.FieldByName(keyField)
+		if field.Kind() == reflect.Ptr {
+			if field.IsNil() {
+				parts = append(parts, "")
+				continue
+			}
+			field = field.Elem()
+		}
+		parts = append(parts, fmt.Sprint(field.Interface()))
+	}
+	return strings.Join(parts, ",")
+}
+{{- if .CRD.UsesComparator "case_insensitive" }}
+
+// equalCaseInsensitive returns true if the supplied strings are equal,
+// ignoring differences in case
+func equalCaseInsensitive(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return strings.EqualFold(*a, *b)
+}
+{{- end }}
+{{- if .CRD.UsesComparator "json" }}
+
+// equalJSONString returns true if the supplied strings contain semantically
+// equal JSON documents
+func equalJSONString(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return equalJSON([]byte(*a), []byte(*b))
+}
+{{- end }}
+{{- if .CRD.UsesComparator "arn_or_name" }}
+
+// equalARNOrName returns true if the supplied strings are equal or if one of
+// them is an ARN identifying a resource with the name in the other, e.g.
+// "arn:aws:kms:us-west-2:111122223333:key/my-key" and "my-key"
+func equalARNOrName(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if *a == *b {
+		return true
+	}
+	return arnResourceName(*a) == *b || arnResourceName(*b) == *a
+}
+
+// arnResourceName returns the name of the resource identified by the supplied
+// ARN, or the empty string if the supplied string is not an ARN
+func arnResourceName(s string) string {
+	if !strings.HasPrefix(s, "arn:") {
+		return ""
+	}
+	// arn:partition:service:region:account-id:resource-type/resource-id
+	// arn:partition:service:region:account-id:resource-type:resource-id
+	parts := strings.SplitN(s, ":", 6)
+	if len(parts) != 6 {
+		return ""
+	}
+	resource := parts[5]
+	if idx := strings.LastIndexAny(resource, "/:"); idx >= 0 {
+		return resource[idx+1:]
+	}
+	return resource
+}
+{{- end }}
+{{- if .CRD.UsesComparator "cidr" }}
+
+// equalCIDR returns true if the supplied strings are CIDR blocks for the same
+// network, e.g. "10.0.0.0/16" and "10.0.1.0/16" or "2001:db8::/32" and
+// "2001:0db8:0000::/32". Strings that are not CIDR blocks are compared
+// as strings.
+func equalCIDR(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	_, aNet, aErr := net.ParseCIDR(*a)
+	_, bNet, bErr := net.ParseCIDR(*b)
+	if aErr != nil || bErr != nil {
+		return *a == *b
+	}
+	return aNet.String() == bNet.String()
+}
+{{- end }}
+{{- if .CRD.UsesComparator "numeric_string" }}
+
+// equalNumericString returns true if the supplied strings contain equal
+// numbers, e.g. "10" and "10.0". Strings that are not numbers are compared
+// as strings.
+func equalNumericString(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	aValue, aErr := strconv.ParseFloat(*a, 64)
+	bValue, bErr := strconv.ParseFloat(*b, 64)
+	if aErr != nil || bErr != nil {
+		return *a == *b
+	}
+	return aValue == bValue
+}
+{{- end }}
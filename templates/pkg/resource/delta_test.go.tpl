@@ -0,0 +1,57 @@
+{{ template "boilerplate" }}
+
+package {{ .CRD.Names.Snake }}
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	svcapitypes "github.com/aws-controllers-k8s/{{ .ServiceIDClean }}-controller/apis/{{ .APIVersion }}"
+)
+{{- $specFields := .CRD.SpecFields }}
+
+func TestNewResourceDelta_Equal(t *testing.T) {
+	delta := newResourceDelta(newSampleResource(), newSampleResource())
+	if len(delta.Differences) != 0 {
+		t.Errorf(
+			"expected no differences between equal resources but got %d",
+			len(delta.Differences),
+		)
+	}
+}
+
+func TestNewResourceDelta_Different(t *testing.T) {
+	for _, test := range []struct {
+		path   string
+		change func(ko *svcapitypes.{{ .CRD.Names.Camel }})
+	}{
+{{- range $fieldName := .CRD.SpecFieldNames }}
+{{- if not ($.CRD.IsCompareIgnoredPath $fieldName) }}
+{{- if $value := GoCodeSampleValue $.CRD $fieldName 1 }}
+{{- $field := index $specFields $fieldName }}
+		{
+			"Spec.{{ $field.Names.Camel }}",
+			func(ko *svcapitypes.{{ $.CRD.Names.Camel }}) {
+				ko.Spec.{{ $field.Names.Camel }} = {{ $value }}
+			},
+		},
+{{- end }}
+{{- end }}
+{{- end }}
+	} {
+		a := newSampleResource()
+		b := newSampleResource()
+		test.change(b.ko)
+		delta := newResourceDelta(a, b)
+		if !delta.DifferentAt(test.path) {
+			t.Errorf(
+				"expected a difference at %s but got %d differences elsewhere",
+				test.path, len(delta.Differences),
+			)
+		}
+	}
+}
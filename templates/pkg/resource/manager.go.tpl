@@ -12,9 +12,15 @@ import (
 	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
 	ackmetrics "github.com/aws-controllers-k8s/runtime/pkg/metrics"
 	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+{{- if .CRD.Readiness }}
+	"github.com/aws-controllers-k8s/runtime/pkg/requeue"
+{{- end }}
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+{{- if .CRD.UsesKubeClient }}
+	"sigs.k8s.io/controller-runtime/pkg/client"
+{{- end }}
 
 	svcsdk "github.com/aws/aws-sdk-go/service/{{ .ServiceIDClean }}"
 	svcsdkapi "github.com/aws/aws-sdk-go/service/{{ .ServiceIDClean }}/{{ .ServiceIDClean }}iface"
@@ -49,6 +55,11 @@ type resourceManager struct {
 	// sdk is a pointer to the AWS service API interface exposed by the
 	// aws-sdk-go/services/{alias}/{alias}iface package.
 	sdkapi svcsdkapi.{{ .SDKAPIInterfaceTypeName }}API
+{{- if .CRD.UsesKubeClient }}
+	// kc is the Kubernetes API client used to read and patch related custom
+	// resources, i.e. the resource's parent or children
+	kc client.Client
+{{- end }}
 }
 
 // concreteResource returns a pointer to a resource from the supplied
@@ -72,13 +83,26 @@ func (rm *resourceManager) ReadOne(
 		// Should never happen... if it does, it's buggy code.
 		panic("resource manager's ReadOne() method received resource with nil CR object")
 	}
+{{- if .CRD.HasParent }}
+	// Look the resource up using its parent's identifier without changing
+	// the desired state
+	resolved, _, err := rm.resolveParent(ctx, r)
+	if err != nil {
+		return rm.onError(r, err)
+	}
+	observed, err := rm.sdkFind(ctx, resolved)
+{{- else }}
 	observed, err := rm.sdkFind(ctx, r)
+{{- end }}
 	if err != nil {
 		if observed != nil {
 			return rm.onError(observed, err)
 		}
 		return rm.onError(r, err)
 	}
+{{- if .CRD.HasActions }}
+	setObservedDesiredState(observed.ko)
+{{- end }}
 	return rm.onSuccess(observed)
 }
 
@@ -94,6 +118,17 @@ func (rm *resourceManager) Create(
 		// Should never happen... if it does, it's buggy code.
 		panic("resource manager's Create() method received resource with nil CR object")
 	}
+{{- if .CRD.HasParent }}
+	// Block creation of the resource until its parent is synced
+	resolved, parent, err := rm.resolveParent(ctx, r)
+	if err != nil {
+		return rm.onError(r, err)
+	}
+	if err = rm.ensureParentOwnerReference(ctx, resolved, parent); err != nil {
+		return rm.onError(r, err)
+	}
+	r = resolved
+{{- end }}
 	created, err := rm.sdkCreate(ctx, r)
 	if err != nil {
 		return rm.onError(r, err)
@@ -121,6 +156,16 @@ func (rm *resourceManager) Update(
 		// Should never happen... if it does, it's buggy code.
 		panic("resource manager's Update() method received resource with nil CR object")
 	}
+{{- if .CRD.HasParent }}
+	resolved, parent, err := rm.resolveParent(ctx, desired)
+	if err != nil {
+		return rm.onError(latest, err)
+	}
+	if err = rm.ensureParentOwnerReference(ctx, resolved, parent); err != nil {
+		return rm.onError(latest, err)
+	}
+	desired = resolved
+{{- end }}
 	updated, err := rm.sdkUpdate(ctx, desired, latest, delta)
 	if err != nil {
 		return rm.onError(latest, err)
@@ -139,6 +184,12 @@ func (rm *resourceManager) Delete(
 		// Should never happen... if it does, it's buggy code.
 		panic("resource manager's Update() method received resource with nil CR object")
 	}
+{{- if .CRD.HasChildren }}
+	// Block deletion of the resource until its children are deleted
+	if err := rm.ensureNoChildren(ctx, r); err != nil {
+		return err
+	}
+{{- end }}
 	return rm.sdkDelete(ctx, r)
 }
 
@@ -156,7 +207,9 @@ func (rm *resourceManager) ARNFromName(name string) string {
 }
 
 // newResourceManager returns a new struct implementing
-// acktypes.AWSResourceManager
+// acktypes.AWSResourceManager. The resource manager calls the supplied AWS
+// service API client or, if nil, a client created from the supplied AWS
+// session.
 func newResourceManager(
 	cfg ackcfg.Config,
 	log logr.Logger,
@@ -165,7 +218,11 @@ func newResourceManager(
 	sess *session.Session,
 	id ackv1alpha1.AWSAccountID,
 	region ackv1alpha1.AWSRegion,
+	sdkapi svcsdkapi.{{ .SDKAPIInterfaceTypeName }}API,
 ) (*resourceManager, error) {
+	if sdkapi == nil {
+		sdkapi = svcsdk.New(sess)
+	}
 	return &resourceManager{
 		cfg: cfg,
 		log: log,
@@ -174,7 +231,7 @@ func newResourceManager(
 		awsAccountID: id,
 		awsRegion: region,
 		sess:		 sess,
-		sdkapi:	   svcsdk.New(sess),
+		sdkapi:	   sdkapi,
 	}, nil
 }
 
@@ -208,5 +265,24 @@ func (rm *resourceManager) onSuccess(
 	if !updated {
 		return r, nil
 	}
+{{- if .CRD.Readiness }}
+	if r1.IsBeingDeleted() {
+		// Don't prevent the resource from being deleted, whatever its
+		// lifecycle status
+		return r1, nil
+	}
+	for _, condition := range r1.Conditions() {
+		if condition.Type == ackv1alpha1.ConditionTypeTerminal &&
+			condition.Status == corev1.ConditionTrue {
+			// resource lifecycle status is a failed state
+			return r1, ackerr.Terminal
+		}
+	}
+	if lifecycleInProgress(r1.ko) {
+		// The resource is transitioning between states. Requeue with backoff
+		// until it settles.
+		return r1, requeue.Needed(ackerr.TemporaryOutOfSync)
+	}
+{{- end }}
 	return r1, nil
 }
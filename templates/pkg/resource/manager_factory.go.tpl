@@ -11,7 +11,11 @@ import (
 	ackmetrics "github.com/aws-controllers-k8s/runtime/pkg/metrics"
 	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
 	"github.com/aws/aws-sdk-go/aws/session"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/{{ .ServiceIDClean }}/{{ .ServiceIDClean }}iface"
 	"github.com/go-logr/logr"
+{{- if .CRD.UsesKubeClient }}
+	"sigs.k8s.io/controller-runtime/pkg/client"
+{{- end }}
 
 	svcresource "github.com/aws-controllers-k8s/{{ .ServiceIDClean }}-controller/pkg/resource"
 )
@@ -22,6 +26,14 @@ type resourceManagerFactory struct {
 	sync.RWMutex
 	// rmCache contains resource managers for a particular AWS account ID
 	rmCache map[string]*resourceManager
+	// sdkapi is the AWS service API client called by the resource managers
+	// in place of one created from their AWS session, if not nil
+	sdkapi svcsdkapi.{{ .SDKAPIInterfaceTypeName }}API
+{{- if .CRD.UsesKubeClient }}
+	// kc is the Kubernetes API client used by the resource managers to read
+	// and patch related custom resources
+	kc client.Client
+{{- end }}
 }
 
 // ResourcePrototype returns an AWSResource that resource managers produced by
@@ -53,10 +65,13 @@ func (f *resourceManagerFactory) ManagerFor(
 	f.Lock()
 	defer f.Unlock()
 
-	rm, err := newResourceManager(cfg, log, metrics, rr, sess, id, region)
+	rm, err := newResourceManager(cfg, log, metrics, rr, sess, id, region, f.sdkapi)
 	if err != nil {
 		return nil, err
 	}
+{{- if .CRD.UsesKubeClient }}
+	rm.kc = f.kc
+{{- end }}
 	f.rmCache[rmId] = rm
 	return rm, nil
 }
@@ -76,12 +91,39 @@ func (f *resourceManagerFactory) RequeueOnSuccessSeconds() int {
 {{- end }}
 }
 
+{{- if .CRD.UsesKubeClient }}
+
+// SetKubeClient sets the Kubernetes API client used by the resource managers
+// produced by this factory to read and patch related custom resources
+func (f *resourceManagerFactory) SetKubeClient(c client.Client) {
+	f.Lock()
+	defer f.Unlock()
+	f.kc = c
+	for _, rm := range f.rmCache {
+		rm.kc = c
+	}
+}
+{{- end }}
+
 func newResourceManagerFactory() *resourceManagerFactory {
 	return &resourceManagerFactory{
 		rmCache: map[string]*resourceManager{},
 	}
 }
 
+// NewManagerFactoryWithSDKAPI returns a resource manager factory producing
+// resource managers that call the supplied AWS service API client, e.g. the
+// in-memory fake of the service API, instead of the real AWS service API.
+// Tests register the factory with the reconcilers they exercise in place of
+// the factory registered with the resource registry.
+func NewManagerFactoryWithSDKAPI(
+	sdkapi svcsdkapi.{{ .SDKAPIInterfaceTypeName }}API,
+) acktypes.AWSResourceManagerFactory {
+	f := newResourceManagerFactory()
+	f.sdkapi = sdkapi
+	return f
+}
+
 func init() {
 	svcresource.RegisterManagerFactory(newResourceManagerFactory())
 }
@@ -0,0 +1,74 @@
+{{ template "boilerplate" }}
+
+package {{ .CRD.Names.Snake }}
+
+import (
+	"context"
+	"testing"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcfg "github.com/aws-controllers-k8s/runtime/pkg/config"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackmetrics "github.com/aws-controllers-k8s/runtime/pkg/metrics"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/go-logr/logr"
+
+	"github.com/aws-controllers-k8s/{{ .ServiceIDClean }}-controller/pkg/fake"
+)
+
+// newFakeResourceManager returns a resource manager calling the supplied
+// in-memory fake of the service API
+func newFakeResourceManager(t *testing.T, api *fake.API) *resourceManager {
+	t.Helper()
+	rm, err := NewManagerFactoryWithSDKAPI(api).ManagerFor(
+		ackcfg.Config{},
+		logr.Discard(),
+		ackmetrics.NewMetrics("{{ .ServiceIDClean }}"),
+		nil,
+		nil,
+		ackv1alpha1.AWSAccountID(api.AccountID),
+		ackv1alpha1.AWSRegion(api.Region),
+	)
+	if err != nil {
+		t.Fatalf("ManagerFor: unexpected error: %v", err)
+	}
+	return rm.(*resourceManager)
+}
+{{- if and .CRD.Ops.Create .CRD.Ops.Delete (or .CRD.Ops.ReadOne .CRD.Ops.GetAttributes .CRD.Ops.ReadMany) (not .CRD.HasParent) (not .CRD.HasChildren) }}
+
+func TestResourceManager_Lifecycle(t *testing.T) {
+	ctx := context.Background()
+	rm := newFakeResourceManager(t, fake.New())
+
+	created, err := rm.Create(ctx, newSampleResource())
+	if err != nil {
+		t.Fatalf("Create: unexpected error: %v", err)
+	}
+	latest, err := rm.ReadOne(ctx, created)
+	if err != nil {
+		t.Fatalf("ReadOne: unexpected error: %v", err)
+	}
+	if err = rm.Delete(ctx, latest); err != nil {
+		t.Fatalf("Delete: unexpected error: %v", err)
+	}
+	if _, err = rm.ReadOne(ctx, latest); err != ackerr.NotFound {
+		t.Errorf("ReadOne: expected NotFound after Delete but got %v", err)
+	}
+}
+{{- end }}
+{{- if .CRD.Ops.Create }}
+
+func TestResourceManager_CreateError(t *testing.T) {
+	api := fake.New()
+	injected := awserr.New("InternalFailure", "injected failure", nil)
+	api.SetError("{{ .CRD.Ops.Create.ExportedName }}", injected)
+	rm := newFakeResourceManager(t, api)
+
+	if _, err := rm.Create(context.Background(), newSampleResource()); err != injected {
+		t.Errorf("Create: expected the injected error but got %v", err)
+	}
+	if n := api.Len("{{ .CRD.Names.Original }}"); n != 0 {
+		t.Errorf("expected no {{ .CRD.Names.Camel }} records but got %d", n)
+	}
+}
+{{- end }}
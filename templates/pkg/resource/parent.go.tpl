@@ -0,0 +1,156 @@
+{{ template "boilerplate" }}
+
+package {{ .CRD.Names.Snake }}
+
+import (
+	"context"
+	"fmt"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	"github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcapitypes "github.com/aws-controllers-k8s/{{ .ServiceIDClean }}-controller/apis/{{ .APIVersion }}"
+)
+{{- $parent := .CRD.Parent }}
+{{- $parentField := .CRD.ParentField }}
+{{- $parentIDPath := .CRD.ParentIdentifierFieldPath }}
+{{- $refNames := .CRD.ParentRefFieldNames }}
+
+// resolveParent finds the parent {{ $parent.Kind }} custom resource of the
+// supplied resource, either from the Spec.{{ $refNames.Camel }} reference or
+// from the value of the Spec.{{ $parentField.Names.Camel }} field, and returns
+// a copy of the resource whose Spec.{{ $parentField.Names.Camel }} field carries
+// the parent's identifier, together with the parent custom resource. The
+// returned parent is nil if the parent isn't managed by a {{ $parent.Kind }}
+// custom resource. The supplied resource is left untouched.
+//
+// An error requeueing the resource is returned if the parent has not yet been
+// synced with the backend AWS service API, unless the resource is being
+// deleted, since the parent may never be synced again.
+func (rm *resourceManager) resolveParent(
+	ctx context.Context,
+	r *resource,
+) (*resource, *svcapitypes.{{ $parent.Names.Camel }}, error) {
+	if rm.kc == nil {
+		return nil, nil, fmt.Errorf(
+			"unable to resolve the parent {{ $parent.Kind }} of %s: no Kubernetes client",
+			r.ko.Name,
+		)
+	}
+	ko := r.ko.DeepCopy()
+	resolved := &resource{ko}
+	parent := &svcapitypes.{{ $parent.Names.Camel }}{}
+	if ko.Spec.{{ $refNames.Camel }} != nil {
+		key := k8stypes.NamespacedName{
+			Namespace: ko.Namespace,
+			Name:      ko.Spec.{{ $refNames.Camel }}.Name,
+		}
+		if err := rm.kc.Get(ctx, key, parent); err != nil {
+			if apierrors.IsNotFound(err) && r.IsBeingDeleted() {
+				// Nothing to resolve: the resource can't exist in the
+				// backend AWS service API without its parent
+				return resolved, nil, nil
+			}
+			return nil, nil, requeue.NeededAfter(
+				fmt.Errorf("unable to get parent {{ $parent.Kind }} %s: %v", key, err),
+				requeue.DefaultRequeueAfterDuration,
+			)
+		}
+	} else if ko.Spec.{{ $parentField.Names.Camel }} != nil {
+		parents := &svcapitypes.{{ $parent.Names.Camel }}List{}
+		if err := rm.kc.List(ctx, parents, client.InNamespace(ko.Namespace)); err != nil {
+			return nil, nil, err
+		}
+		found := false
+		for i := range parents.Items {
+			candidate := &parents.Items[i]
+			if candidate.{{ $parentIDPath }} != nil &&
+				*candidate.{{ $parentIDPath }} == *ko.Spec.{{ $parentField.Names.Camel }} {
+				parent = candidate
+				found = true
+				break
+			}
+		}
+		if !found {
+			// The parent exists in the backend AWS service API but is not
+			// managed by a {{ $parent.Kind }} custom resource, so there is
+			// nothing to wait for
+			return resolved, nil, nil
+		}
+	} else {
+		return nil, nil, fmt.Errorf(
+			"one of spec.{{ $parentField.Names.CamelLower }} or spec.{{ $refNames.CamelLower }} must be set",
+		)
+	}
+
+	if !r.IsBeingDeleted() && !parentIsSynced(parent) {
+		return nil, nil, requeue.NeededAfter(
+			fmt.Errorf("parent {{ $parent.Kind }} %s is not yet synced", parent.Name),
+			requeue.DefaultRequeueAfterDuration,
+		)
+	}
+	ko.Spec.{{ $parentField.Names.Camel }} = parent.{{ $parentIDPath }}
+	return resolved, parent, nil
+}
+
+// parentIsSynced returns true if the supplied parent custom resource has
+// been created in the backend AWS service API and is not in a terminal state
+func parentIsSynced(
+	parent *svcapitypes.{{ $parent.Names.Camel }},
+) bool {
+	if parent.{{ $parentIDPath }} == nil {
+		return false
+	}
+	for _, condition := range parent.Status.Conditions {
+		if condition.Type == ackv1alpha1.ConditionTypeTerminal &&
+			condition.Status == corev1.ConditionTrue {
+			return false
+		}
+		if condition.Type == ackv1alpha1.ConditionTypeResourceSynced &&
+			condition.Status != corev1.ConditionTrue {
+			return false
+		}
+	}
+	return true
+}
+
+// ensureParentOwnerReference adds an owner reference to the supplied parent
+// custom resource to the resource's metadata, if not already present, and
+// patches the resource's custom resource with it. The owner reference makes
+// the Kubernetes garbage collector delete the resource once the parent custom
+// resource is gone, and makes foreground deletion of the parent wait for the
+// resource. Nothing is done if the parent isn't managed by a custom resource.
+func (rm *resourceManager) ensureParentOwnerReference(
+	ctx context.Context,
+	r *resource,
+	parent *svcapitypes.{{ $parent.Names.Camel }},
+) error {
+	if parent == nil {
+		return nil
+	}
+	for _, ref := range r.ko.OwnerReferences {
+		if ref.UID == parent.UID {
+			return nil
+		}
+	}
+	blockOwnerDeletion := true
+	ownerRef := metav1.OwnerReference{
+		APIVersion:         svcapitypes.GroupVersion.String(),
+		Kind:               "{{ $parent.Kind }}",
+		Name:               parent.Name,
+		UID:                parent.UID,
+		BlockOwnerDeletion: &blockOwnerDeletion,
+	}
+	obj := r.ko.DeepCopy()
+	obj.OwnerReferences = append(obj.OwnerReferences, ownerRef)
+	if err := rm.kc.Patch(ctx, obj, client.MergeFrom(r.ko)); err != nil {
+		return err
+	}
+	r.ko.OwnerReferences = obj.OwnerReferences
+	return nil
+}
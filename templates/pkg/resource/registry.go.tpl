@@ -5,6 +5,9 @@ package resource
 import (
 	ackrt "github.com/aws-controllers-k8s/runtime/pkg/runtime"
 	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+{{- if .GeneratorConfig.ResourceContainsParent }}
+	"sigs.k8s.io/controller-runtime/pkg/client"
+{{- end }}
 )
 
 // +kubebuilder:rbac:groups=services.k8s.aws,resources=adoptedresources,verbs=get;list;watch;create;update;patch;delete
@@ -30,3 +33,22 @@ func GetManagerFactories() []acktypes.AWSResourceManagerFactory {
 func RegisterManagerFactory(f acktypes.AWSResourceManagerFactory) {
 	reg.RegisterResourceManagerFactory(f)
 }
+{{- if .GeneratorConfig.ResourceContainsParent }}
+
+// KubeClientSetter is implemented by the resource manager factories whose
+// resource managers read or patch related custom resources, e.g. the parent
+// of a child resource
+type KubeClientSetter interface {
+	SetKubeClient(client.Client)
+}
+
+// SetKubeClient sets the Kubernetes API client used by the resource managers
+// of the registered resource manager factories that need one
+func SetKubeClient(c client.Client) {
+	for _, f := range reg.GetResourceManagerFactories() {
+		if s, ok := f.(KubeClientSetter); ok {
+			s.SetKubeClient(c)
+		}
+	}
+}
+{{- end }}
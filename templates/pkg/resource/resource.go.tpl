@@ -12,11 +12,6 @@ import (
 	svcapitypes "github.com/aws-controllers-k8s/{{ .ServiceIDClean }}-controller/apis/{{ .APIVersion}}"
 )
 
-// Hack to avoid import errors during build...
-var (
-	_ = &ackerrors.MissingNameIdentifier
-)
-
 // resource implements the `aws-controller-k8s/runtime/pkg/types.AWSResource`
 // interface
 type resource struct {
@@ -74,13 +69,11 @@ func (r *resource) SetObjectMeta(meta metav1.ObjectMeta) {
 // SetIdentifiers sets the Spec or Status field that is referenced as the unique
 // resource identifier
 func (r *resource) SetIdentifiers(identifier *ackv1alpha1.AWSIdentifiers) error {
-{{- if $idField := .CRD.SpecIdentifierField }}
-	if identifier.NameOrID == nil {
-		return ackerrors.MissingNameIdentifier
-	}
-	r.ko.Spec.{{ $idField }} = identifier.NameOrID
+{{- if .CRD.HasCompositeIdentifier }}
+{{ GoCodeSetResourceIdentifiers .CRD "identifier" "r.ko" 1 -}}
+}
 {{- else }}
-	r.ko.Status.ACKResourceMetadata.ARN = identifier.ARN
-{{- end }}
+{{ GoCodeSetResourceIdentifiers .CRD "identifier" "r.ko" 1 }}
 	return nil
-}
\ No newline at end of file
+}
+{{- end }}
\ No newline at end of file
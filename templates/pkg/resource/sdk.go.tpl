@@ -4,31 +4,33 @@ package {{ .CRD.Names.Snake }}
 
 import (
 	"context"
+{{- if .CRD.HasJSONFields }}
+	"encoding/json"
+	"fmt"
+{{- end }}
 	"strings"
+{{- if .CRD.DeletionWaiter }}
+	"time"
+{{- end }}
 
 	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
 	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
 	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
 	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+{{- if or .CRD.DeletionWaiter .CRD.DeletingLifecycleValues }}
+	"github.com/aws-controllers-k8s/runtime/pkg/requeue"
+{{- end }}
 	"github.com/aws/aws-sdk-go/aws"
 	svcsdk "github.com/aws/aws-sdk-go/service/{{ .ServiceIDClean }}"
 	corev1 "k8s.io/api/core/v1"
+{{- if .CRD.HasJSONFields }}
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+{{- end }}
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	svcapitypes "github.com/aws-controllers-k8s/{{.ServiceIDClean }}-controller/apis/{{ .APIVersion }}"
 )
 
-// Hack to avoid import errors during build...
-var (
-	_ = &metav1.Time{}
-	_ = strings.ToLower("")
-	_ = &aws.JSONValue{}
-	_ = &svcsdk.{{ .SDKAPIInterfaceTypeName}}{}
-	_ = &svcapitypes.{{ .CRD.Names.Camel }}{}
-	_ = ackv1alpha1.AWSAccountID("")
-	_ = &ackerr.NotFound
-)
-
 // sdkFind returns SDK-specific information about a supplied resource
 {{ if .CRD.Ops.ReadOne }}
 	{{- template "sdk_find_read_one" . }}
@@ -131,6 +133,23 @@ func (rm *resourceManager) sdkDelete(
 	defer exit(err)
 
 {{- if .CRD.Ops.Delete }}
+{{- $deleting := .CRD.DeletingLifecycleValues }}
+{{- if $deleting }}
+	// Calling the Delete API while the backend AWS service API is already
+	// deleting the resource fails, so only wait for the resource to disappear
+	switch lifecycleStatus(r.ko) {
+{{- if $deleted := .CRD.DeletedLifecycleValues }}
+	case {{ range $x, $value := $deleted -}}{{ if ne ($x) (0) }}, {{ end }}"{{ $value }}"{{ end }}:
+		return nil
+{{- end }}
+	case {{ range $x, $value := $deleting -}}{{ if ne ($x) (0) }}, {{ end }}"{{ $value }}"{{ end }}:
+{{- if $waiter := .CRD.DeletionWaiter }}
+		return requeue.NeededAfter(nil, {{ $waiter.Delay }}*time.Second)
+{{- else }}
+		return requeue.NeededAfter(nil, requeue.DefaultRequeueAfterDuration)
+{{- end }}
+	}
+{{- end }}
 {{- if $hookCode := Hook .CRD "sdk_delete_pre_build_request" }}
 {{ $hookCode }}
 {{- end }}
@@ -146,12 +165,40 @@ func (rm *resourceManager) sdkDelete(
 {{- if $hookCode := Hook .CRD "sdk_delete_post_build_request" }}
 {{ $hookCode }}
 {{- end }}
-	_, err = rm.sdkapi.{{ .CRD.Ops.Delete.Name }}WithContext(ctx, input)
+	_, err = rm.sdkapi.{{ .CRD.Ops.Delete.ExportedName }}WithContext(ctx, input)
 	rm.metrics.RecordAPICall("DELETE", "{{ .CRD.Ops.Delete.Name }}", err)
 {{- if $hookCode := Hook .CRD "sdk_delete_post_request" }}
 {{ $hookCode }}
 {{- end }}
+{{- if $waiter := .CRD.DeletionWaiter }}
+	if err != nil {
+		return err
+	}
+	// The resource is deleted asynchronously by the backend AWS service API.
+	// Don't allow the resource's finalizer to be removed until the resource
+	// has actually disappeared.
+{{- $values := .CRD.DeletedLifecycleValues }}
+{{- if $values }}
+	observed, err := rm.sdkFind(ctx, r)
+{{- else }}
+	_, err = rm.sdkFind(ctx, r)
+{{- end }}
+	if err == ackerr.NotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+{{- if $values }}
+	switch lifecycleStatus(observed.ko) {
+	case {{ range $x, $value := $values -}}{{ if ne ($x) (0) }}, {{ end }}"{{ $value }}"{{ end }}:
+		return nil
+	}
+{{- end }}
+	return requeue.NeededAfter(nil, {{ $waiter.Delay }}*time.Second)
+{{- else }}
 	return err
+{{- end }}
 {{- else }}
 	// TODO(jaypipes): Figure this out...
 	return nil
@@ -250,6 +297,36 @@ func (rm *resourceManager) updateConditions (
 		}
 	}
 
+{{- if $readiness := .CRD.Readiness }}
+
+	// The resource is only synced once its lifecycle status field has one of
+	// the values indicating that the resource is ready
+	if onSuccess {
+		if syncCondition == nil {
+			syncCondition = &ackv1alpha1.Condition{
+				Type: ackv1alpha1.ConditionTypeResourceSynced,
+			}
+			ko.Status.Conditions = append(ko.Status.Conditions, syncCondition)
+		}
+		syncCondition.Status = corev1.ConditionFalse
+		switch status := lifecycleStatus(ko); status {
+		case {{ range $x, $value := $readiness.Ready -}}{{ if ne ($x) (0) }}, {{ end }}"{{ $value }}"{{ end }}:
+			syncCondition.Status = corev1.ConditionTrue
+{{- if $readiness.Failed }}
+		case {{ range $x, $value := $readiness.Failed -}}{{ if ne ($x) (0) }}, {{ end }}"{{ $value }}"{{ end }}:
+			if terminalCondition == nil {
+				terminalCondition = &ackv1alpha1.Condition{
+					Type: ackv1alpha1.ConditionTypeTerminal,
+				}
+				ko.Status.Conditions = append(ko.Status.Conditions, terminalCondition)
+			}
+			terminalCondition.Status = corev1.ConditionTrue
+			errorMessage := "resource is in a failed state: " + status
+			terminalCondition.Message = &errorMessage
+{{- end }}
+		}
+	}
+{{- end }}
 {{- if $reconcileRequeuOnSuccessSeconds := .CRD.ReconcileRequeuOnSuccessSeconds }}
 	if syncCondition == nil && onSuccess {
 		syncCondition = &ackv1alpha1.Condition{
@@ -277,6 +354,34 @@ func (rm *resourceManager) updateConditions (
 	return nil, false // not updated
 }
 
+{{- if .CRD.Readiness }}
+// lifecycleStatus returns the value of the resource's lifecycle status field
+// or the empty string if the field is not set
+func lifecycleStatus(
+	ko *svcapitypes.{{ .CRD.Names.Camel }},
+) string {
+{{ GoCodeLifecycleStatus .CRD "ko" 1 -}}
+}
+
+// lifecycleInProgress returns true if the resource's lifecycle status field
+// indicates that the resource is transitioning between states
+func lifecycleInProgress(
+	ko *svcapitypes.{{ .CRD.Names.Camel }},
+) bool {
+{{- if .CRD.Readiness.InProgress }}
+	switch lifecycleStatus(ko) {
+	case {{ range $x, $value := .CRD.Readiness.InProgress -}}{{ if ne ($x) (0) }}, {{ end }}"{{ $value }}"{{ end }}:
+		return true
+	default:
+		return false
+	}
+{{- else }}
+	// No in_progress values specified for this resource in generator config
+	return false
+{{- end }}
+}
+
+{{ end -}}
 // terminalAWSError returns awserr, true; if the supplied error is an aws Error type
 // and if the exception indicates that it is a Terminal exception
 // 'Terminal' exception are specified in generator configuration
@@ -24,7 +24,7 @@ func (rm *resourceManager) sdkFind(
 {{- if $hookCode := Hook .CRD "sdk_get_attributes_post_build_request" }}
 {{ $hookCode }}
 {{- end }}
-	var resp {{ .CRD.GetOutputShapeGoType .CRD.Ops.GetAttributes }}
+	var resp {{ .CRD.GetOutputShapeGoType .CRD.Ops.GetAttributes }}; _ = resp;
 	resp, err = rm.sdkapi.{{ .CRD.Ops.GetAttributes.ExportedName }}WithContext(ctx, input)
 {{- if $hookCode := Hook .CRD "sdk_get_attributes_post_request" }}
 {{ $hookCode }}
@@ -0,0 +1,170 @@
+{{ template "boilerplate" }}
+
+package {{ .CRD.Names.Snake }}
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/{{ .ServiceIDClean }}"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	svcapitypes "github.com/aws-controllers-k8s/{{ .ServiceIDClean }}-controller/apis/{{ .APIVersion }}"
+	"github.com/aws-controllers-k8s/{{ .ServiceIDClean }}-controller/pkg/fake"
+)
+{{- $specFields := .CRD.SpecFields }}
+
+// newSampleResource returns a resource with its Spec fields set to sample
+// values derived from the API model. Fields that can't be set without a
+// Kubernetes API server, i.e. SecretKeyReference fields, are left unset.
+func newSampleResource() *resource {
+	ko := &svcapitypes.{{ .CRD.Names.Camel }}{}
+{{- range $fieldName := .CRD.SpecFieldNames }}
+{{- if $value := GoCodeSampleValue $.CRD $fieldName 0 }}
+	ko.Spec.{{ (index $specFields $fieldName).Names.Camel }} = {{ $value }}
+{{- end }}
+{{- end }}
+	return &resource{ko}
+}
+
+// assertSpecRoundTrip fails the test if any Spec field of the supplied CR,
+// which was read back from the API, differs from the same field of the
+// resource that the API call's Input shape was built from. Only the Spec
+// fields that the read operation's Output shape contains are compared, since
+// the others are never read back from the API.
+func assertSpecRoundTrip(
+	t *testing.T,
+	desired *resource,
+	ko *svcapitypes.{{ .CRD.Names.Camel }},
+) {
+	t.Helper()
+	for _, field := range []struct {
+		path string
+		want interface{}
+		got  interface{}
+	}{
+{{- range $fieldName := SpecFieldNamesSetFromReadOutput .CRD }}
+{{- if GoCodeSampleValue $.CRD $fieldName 0 }}
+{{- $field := index $specFields $fieldName }}
+		{"Spec.{{ $field.Names.Camel }}", desired.ko.Spec.{{ $field.Names.Camel }}, ko.Spec.{{ $field.Names.Camel }}},
+{{- end }}
+{{- end }}
+	} {
+		if !reflect.DeepEqual(field.want, field.got) {
+			t.Errorf(
+				"%s: expected %s but got %s",
+				field.path, sampleJSON(field.want), sampleJSON(field.got),
+			)
+		}
+	}
+}
+
+// sampleJSON returns the JSON representation of the supplied value for test
+// failure messages
+func sampleJSON(value interface{}) string {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return err.Error()
+	}
+	return string(b)
+}
+
+func TestNewCreateRequestPayload(t *testing.T) {
+	rm := &resourceManager{}
+	desired := newSampleResource()
+	input, err := rm.newCreateRequestPayload(context.Background(), desired)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if input == nil {
+		t.Fatal("expected a Create Input shape but got nil")
+	}
+{{- range $member := SampleInputMembers .CRD "Create" }}
+{{- if $member.Comparable }}
+	if !reflect.DeepEqual(desired.ko.Spec.{{ $member.FieldName }}, input.{{ $member.Path }}) {
+		t.Errorf(
+			"{{ $member.Path }}: expected %s but got %s",
+			sampleJSON(desired.ko.Spec.{{ $member.FieldName }}), sampleJSON(input.{{ $member.Path }}),
+		)
+	}
+{{- else }}
+	if input.{{ $member.Path }} == nil {
+		t.Error("{{ $member.Path }}: expected to be set from Spec.{{ $member.FieldName }} but got nil")
+	}
+{{- end }}
+{{- end }}
+}
+{{- if and .CRD.Ops.Update (not .CRD.CustomUpdateMethodName) }}
+
+func TestNewUpdateRequestPayload(t *testing.T) {
+	rm := &resourceManager{}
+	desired := newSampleResource()
+	input, err := rm.newUpdateRequestPayload(context.Background(), desired)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if input == nil {
+		t.Fatal("expected an Update Input shape but got nil")
+	}
+{{- range $member := SampleInputMembers .CRD "Update" }}
+{{- if $member.Comparable }}
+	if !reflect.DeepEqual(desired.ko.Spec.{{ $member.FieldName }}, input.{{ $member.Path }}) {
+		t.Errorf(
+			"{{ $member.Path }}: expected %s but got %s",
+			sampleJSON(desired.ko.Spec.{{ $member.FieldName }}), sampleJSON(input.{{ $member.Path }}),
+		)
+	}
+{{- else }}
+	if input.{{ $member.Path }} == nil {
+		t.Error("{{ $member.Path }}: expected to be set from Spec.{{ $member.FieldName }} but got nil")
+	}
+{{- end }}
+{{- end }}
+}
+{{- end }}
+{{- if or .CRD.Ops.ReadOne .CRD.Ops.GetAttributes .CRD.Ops.ReadMany }}
+
+func TestSDKCreate(t *testing.T) {
+	ctx := context.Background()
+	rm := newFakeResourceManager(t, fake.New())
+	desired := newSampleResource()
+	created, err := rm.sdkCreate(ctx, desired)
+	if err != nil {
+		t.Fatalf("sdkCreate: unexpected error: %v", err)
+	}
+	// The fake returns what it was sent
+	latest, err := rm.sdkFind(ctx, created)
+	if err != nil {
+		t.Fatalf("sdkFind: unexpected error: %v", err)
+	}
+	assertSpecRoundTrip(t, desired, latest.ko)
+}
+{{- if and .CRD.Ops.Update (not .CRD.CustomUpdateMethodName) }}
+
+func TestSDKUpdate(t *testing.T) {
+	ctx := context.Background()
+	rm := newFakeResourceManager(t, fake.New())
+	desired := newSampleResource()
+	created, err := rm.sdkCreate(ctx, desired)
+	if err != nil {
+		t.Fatalf("sdkCreate: unexpected error: %v", err)
+	}
+	updated, err := rm.sdkUpdate(ctx, desired, created, newResourceDelta(desired, created))
+	if err != nil {
+		t.Fatalf("sdkUpdate: unexpected error: %v", err)
+	}
+	// The fake returns what it was sent
+	latest, err := rm.sdkFind(ctx, updated)
+	if err != nil {
+		t.Fatalf("sdkFind: unexpected error: %v", err)
+	}
+	assertSpecRoundTrip(t, desired, latest.ko)
+}
+{{- end }}
+{{- end }}
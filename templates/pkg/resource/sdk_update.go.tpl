@@ -8,7 +8,11 @@ func (rm *resourceManager) sdkUpdate(
 	rlog := ackrtlog.FromContext(ctx)
 	exit := rlog.Trace("rm.sdkUpdate")
 	defer exit(err)
-
+{{- if .CRD.HasActions }}
+	if err = rm.syncDesiredState(ctx, desired, latest, delta); err != nil {
+		return nil, err
+	}
+{{- end }}
 {{- if $hookCode := Hook .CRD "sdk_update_pre_build_request" }}
 {{ $hookCode }}
 {{- end }}
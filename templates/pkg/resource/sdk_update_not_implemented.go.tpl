@@ -5,6 +5,11 @@ func (rm *resourceManager) sdkUpdate(
 	latest *resource,
 	delta *ackcompare.Delta,
 ) (*resource, error) {
+{{- if .CRD.HasActions }}
+	if err := rm.syncDesiredState(ctx, desired, latest, delta); err != nil {
+		return nil, err
+	}
+{{- end }}
 	// TODO(jaypipes): Figure this out...
 	return nil, ackerr.NotImplemented
 }
@@ -5,6 +5,11 @@ func (rm *resourceManager) sdkUpdate(
 	latest *resource,
 	delta *ackcompare.Delta,
 ) (*resource, error) {
+{{- if .CRD.HasActions }}
+	if err := rm.syncDesiredState(ctx, desired, latest, delta); err != nil {
+		return nil, err
+	}
+{{- end }}
 	// If any required fields in the input shape are missing, AWS resource is
 	// not created yet. And sdkUpdate should never be called if this is the
 	// case, and it's an error in the generated code if it is...